@@ -0,0 +1,75 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Confirm asks a yes or no question, anything other than yes counts as a no.
+func Confirm(in io.Reader, out io.Writer, question string) (bool, error) {
+	if _, err := fmt.Fprintf(out, "%s [y/N] ", question); err != nil {
+		return false, err
+	}
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// Select asks to choose one of the options by its number, and returns the index of the chosen option.
+// It fails when no valid number is entered, e.g. when the input is not interactive.
+func Select(in io.Reader, out io.Writer, question string, options []string) (int, error) {
+	if in == nil || out == nil {
+		return 0, errors.New("no option selected")
+	}
+
+	if _, err := fmt.Fprintln(out, question); err != nil {
+		return 0, err
+	}
+	for i, option := range options {
+		if _, err := fmt.Fprintf(out, "  %d) %s\n", i+1, option); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := fmt.Fprintf(out, "Enter a number [1-%d]: ", len(options)); err != nil {
+		return 0, err
+	}
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+
+	choice, err := strconv.Atoi(strings.TrimSpace(answer))
+	if err != nil || choice < 1 || choice > len(options) {
+		return 0, fmt.Errorf("no option selected, %q is not a number between 1 and %d", strings.TrimSpace(answer), len(options))
+	}
+
+	return choice - 1, nil
+}
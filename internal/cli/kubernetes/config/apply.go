@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
@@ -27,11 +28,16 @@ import (
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	containerImage = "mongodb/mongodb-atlas-kubernetes-operator"
+	applyTemplate  = `KIND	NAMESPACE	NAME	RESULT{{range .}}
+{{.Kind}}	{{.Namespace}}	{{.Name}}	{{.Action}}{{end}}
+Atlas Resources exported and applied to Kubernetes cluster successfully
+`
 )
 
 type ApplyOpts struct {
@@ -42,6 +48,10 @@ type ApplyOpts struct {
 
 	KubeConfig  string
 	KubeContext string
+
+	// in and promptOut are used to choose between several installed operators
+	in        io.Reader
+	promptOut io.Writer
 }
 
 func (opts *ApplyOpts) ValidateTargetNamespace() error {
@@ -65,17 +75,22 @@ func (opts *ApplyOpts) autoDetectParams(kubeCtl *kubernetes.KubeCtl) error {
 		return nil
 	}
 
-	operatorDeployment, err := kubeCtl.FindAtlasOperator(context.Background())
+	installations, err := operator.FindInstallations(context.Background(), kubeCtl)
+	if err != nil {
+		return fmt.Errorf("unable to auto detect params: %w", err)
+	}
+
+	installation, err := opts.selectInstallation(installations)
 	if err != nil {
 		return fmt.Errorf("unable to auto detect params: %w", err)
 	}
 
 	if opts.targetNamespace == "" {
-		opts.targetNamespace = operatorDeployment.Namespace
+		opts.targetNamespace = installation.Namespace
 	}
 
 	if opts.operatorVersion == "" {
-		image := operatorDeployment.Spec.Template.Spec.Containers[0].Image
+		image := installation.Deployment.Spec.Template.Spec.Containers[0].Image
 		hasContainerImageRepo := strings.Contains(image, containerImage+":")
 		version := getOperatorMajorVersion(image)
 		if !hasContainerImageRepo || version == "" {
@@ -87,61 +102,164 @@ func (opts *ApplyOpts) autoDetectParams(kubeCtl *kubernetes.KubeCtl) error {
 	return nil
 }
 
-func (opts *ApplyOpts) Run() error {
+// selectInstallation chooses the operator managing the target namespace. When several operators are
+// candidates, the one installed in the target namespace is chosen, otherwise the user is asked to choose.
+func (opts *ApplyOpts) selectInstallation(installations []operator.Installation) (*operator.Installation, error) {
+	candidates := installations
+	if opts.targetNamespace != "" {
+		candidates = operator.InstallationsFor(installations, opts.targetNamespace)
+	}
+
+	switch {
+	case len(candidates) == 0 && opts.targetNamespace != "":
+		return nil, fmt.Errorf("couldn't find an operator watching namespace %s", opts.targetNamespace)
+	case len(candidates) == 0:
+		return nil, kubernetes.ErrOperatorNotFound
+	case len(candidates) == 1 || candidates[0].Namespace == opts.targetNamespace:
+		return &candidates[0], nil
+	}
+
+	options := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		options = append(options, candidate.String())
+	}
+
+	choice, err := cli.Select(opts.in, opts.promptOut, "More than one Atlas Kubernetes Operator is installed, choose the one to apply resources for:", options)
+	if err != nil {
+		return nil, fmt.Errorf("%w. set --%s to choose the operator", err, flag.OperatorTargetNamespace)
+	}
+
+	return &candidates[choice], nil
+}
+
+// newConfigApply connects to the cluster, detects missing parameters from the operator
+// installation and sets up the exporter matching the requested CRD type.
+func (opts *ApplyOpts) newConfigApply() (*operator.ConfigApply, error) {
+	kubeCtl, err := opts.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return opts.newProjectConfigApply(kubeCtl, opts.ProjectID, opts.targetNamespace)
+}
+
+func (opts *ApplyOpts) connect() (*kubernetes.KubeCtl, error) {
 	kubeCtl, err := kubernetes.NewKubeCtl(opts.KubeConfig, opts.KubeContext)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = opts.autoDetectParams(kubeCtl)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubeCtl, nil
+}
+
+// newProjectConfigApply sets up the export and apply of a single project to the given namespace.
+func (opts *ApplyOpts) newProjectConfigApply(kubeCtl *kubernetes.KubeCtl, projectID, namespace string) (*operator.ConfigApply, error) {
+	var err error
+	params := operator.NewConfigApplyParams{
+		OrgID:     opts.OrgID,
+		ProjectID: projectID,
+		KubeCtl:   kubeCtl,
+	}
+
+	switch opts.crdType {
+	case features.CRDTypeGenerated:
+		params.GeneratedExporter, err = opts.setupGeneratedExporter(projectID, opts.OrgID, namespace, true)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		atlasCRDs, err := features.NewAtlasCRDs(opts.crdsProvider, opts.operatorVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		params.Exporter = operator.NewConfigExporter(opts.store, opts.profile, projectID, opts.OrgID).
+			WithClustersNames(opts.clusterName).
+			WithTargetNamespace(namespace).
+			WithTargetOperatorVersion(opts.operatorVersion).
+			WithSecretsData(true).
+			WithFeatureValidator(atlasCRDs).
+			WithPatcher(atlasCRDs).
+			WithDataFederationNames(opts.dataFederationName).
+			WithIndependentResources(opts.independentResources).
+			WithMaxWorkers(opts.workers)
+	}
+
+	return operator.NewConfigApply(params).
+		WithTargetOperatorVersion(opts.operatorVersion).
+		WithNamespace(namespace), nil
+}
+
+func (opts *ApplyOpts) Run() error {
+	if opts.allProjects {
+		return opts.runOrgWide()
+	}
+
+	configApply, err := opts.newConfigApply()
 	if err != nil {
 		return err
 	}
 
-	atlasCRDs, err := features.NewAtlasCRDs(opts.crdsProvider, opts.operatorVersion)
+	results, err := configApply.Run()
 	if err != nil {
 		return err
 	}
 
-	exporter := operator.NewConfigExporter(opts.store, opts.profile, opts.ProjectID, opts.OrgID).
-		WithClustersNames(opts.clusterName).
-		WithTargetNamespace(opts.targetNamespace).
-		WithTargetOperatorVersion(opts.operatorVersion).
-		WithSecretsData(true).
-		WithFeatureValidator(atlasCRDs).
-		WithPatcher(atlasCRDs).
-		WithDataFederationNames(opts.dataFederationName).
-		WithIndependentResources(opts.independentResources)
-	err = operator.NewConfigApply(
-		operator.NewConfigApplyParams{
-			OrgID:     opts.OrgID,
-			ProjectID: opts.ProjectID,
-			KubeCtl:   kubeCtl,
-			Exporter:  exporter,
-		},
-	).WithTargetOperatorVersion(opts.operatorVersion).
-		WithNamespace(opts.targetNamespace).
-		Run()
+	return opts.Print(results)
+}
 
+// runOrgWide applies every selected project of the organization to its own namespace,
+// creating the namespace when needed.
+func (opts *ApplyOpts) runOrgWide() error {
+	// the operator is still looked up to detect its version, the namespace it runs in is not used
+	kubeCtl, err := opts.connect()
 	if err != nil {
 		return err
 	}
 
-	return opts.Print("Atlas Resources exported and applied to Kubernetes cluster successfully")
+	var results []operator.ApplyResult
+	err = opts.forEachOrgProject(opts.OrgID, func(project operator.OrgProject) error {
+		configApply, err := opts.newProjectConfigApply(kubeCtl, project.ID, project.Namespace)
+		if err != nil {
+			return err
+		}
+
+		projectResults, err := configApply.WithCreateNamespace(true).Run()
+		if err != nil {
+			return err
+		}
+		results = append(results, projectResults...)
+
+		return nil
+	})
+
+	return errors.Join(err, opts.Print(results))
 }
 
 // ApplyBuilder builds a cobra.Command that can run as:
 // atlas kubernetes config apply --orgId=orgId --projectId=projectId --clusterName="cluster-1,cluster-2...cluster-N" --targetNamespace=my-namespace.
 func ApplyBuilder() *cobra.Command {
 	const use = "apply"
-	opts := &ApplyOpts{}
+	opts := &ApplyOpts{
+		GenerateOpts: GenerateOpts{fs: afero.NewOsFs()},
+	}
+	opts.Template = applyTemplate
 
 	cmd := &cobra.Command{
 		Use:     use,
 		Args:    require.NoArgs,
 		Aliases: cli.GenerateAliases(use),
 		Short:   "Generate and apply Kubernetes configuration resources for use with Atlas Kubernetes Operator.",
-		Long:    `This command exports configurations for Atlas objects including projects, deployments, and users directly into Kubernetes, allowing you to manage these resources using the Atlas Kubernetes Operator. For more information, see https://www.mongodb.com/docs/atlas/atlas-operator/.`,
+		Long: `This command exports configurations for Atlas objects including projects, deployments, and users directly into Kubernetes, allowing you to manage these resources using the Atlas Kubernetes Operator. For more information, see https://www.mongodb.com/docs/atlas/atlas-operator/.
+
+Resources are server-side applied, so running the command again against a namespace that already holds them updates them in place. Only the fields set by this command are owned by it, fields managed by other tools are left untouched.
+
+When the target namespace or the operator version is omitted, they are detected from the operators installed in the cluster. When more than one operator manages the target namespace, the one installed in it is used, otherwise you are asked to choose one.`,
 		Example: `# Export and apply all supported resources of a specific project:
   atlas kubernetes config apply --projectId=<projectId>
 
@@ -152,16 +270,23 @@ func ApplyBuilder() *cobra.Command {
   atlas kubernetes config apply --projectId=<projectId> --clusterName=<cluster-name-1, cluster-name-2> --targetNamespace=<namespace>
 
   # Export and apply all supported resources of a specific project to a specific namespace restricting the version of the Atlas Kubernetes Operator:
-  atlas kubernetes config apply --projectId=<projectId> --targetNamespace=<namespace> --operatorVersion=1.5.1`,
+  atlas kubernetes config apply --projectId=<projectId> --targetNamespace=<namespace> --operatorVersion=1.5.1
+
+  # Export and apply the resources of a specific project as auto-generated CRDs:
+  atlas kubernetes config apply --projectId=<projectId> --targetNamespace=<namespace> --crdType=generated
+
+  # Export and apply every project of an organization whose name starts with "prod-", each project to its own namespace:
+  atlas kubernetes config apply --orgId=<orgId> --allProjects --projectNameFilter="prod-*"`,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			return opts.OrgOpts.PreRunE(
-				opts.ValidateProjectID,
+				opts.validateProjectSelection(&opts.ProjectOpts, &opts.OrgOpts),
 				opts.ValidateTargetNamespace,
 				opts.ValidateOperatorVersion,
 				opts.initStores(cmd.Context()),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts.in, opts.promptOut = cmd.InOrStdin(), cmd.ErrOrStderr()
 			return opts.Run()
 		},
 	}
@@ -177,6 +302,12 @@ func ApplyBuilder() *cobra.Command {
 	flags.StringVar(&opts.KubeContext, flag.KubernetesClusterContext, "", usage.KubernetesClusterContext)
 	flags.StringSliceVar(&opts.dataFederationName, flag.DataFederationName, []string{}, usage.ExporterDataFederationName)
 	flags.BoolVar(&opts.independentResources, flag.IndependentResources, false, usage.IndependentResources)
+	flags.StringVar(&opts.crdType, flag.CRDType, features.CRDTypeCurated, usage.CRDType)
+	flags.BoolVar(&opts.allProjects, flag.AllProjects, false, usage.AllProjects)
+	flags.StringVar(&opts.projectNameFilter, flag.ProjectNameFilter, "", usage.ProjectNameFilter)
+	flags.StringToStringVar(&opts.projectTags, flag.ProjectTag, nil, usage.ProjectTag)
+	flags.IntVar(&opts.workers, flag.Workers, operator.DefaultMaxWorkers, usage.Workers)
+	flags.StringVar(&opts.crdsPath, flag.CRDsPath, "", usage.CRDsPath)
 
 	return cmd
 }
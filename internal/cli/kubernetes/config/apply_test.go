@@ -17,9 +17,13 @@
 package config
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetOperatorMajorVersion(t *testing.T) {
@@ -43,3 +47,39 @@ func TestGetOperatorMajorVersion(t *testing.T) {
 		assert.Equal(t, "2.12.0", getOperatorMajorVersion("example.io/mongodb/mongodb-atlas-kubernetes-operator:2.12.3"))
 	})
 }
+
+func TestSelectInstallation(t *testing.T) {
+	installations := []operator.Installation{
+		{Namespace: "tenant-a", Version: "2.13.0", WatchNamespaces: []string{"tenant-a"}},
+		{Namespace: "tenant-b", Version: "2.14.0", WatchNamespaces: []string{"tenant-b", "shared"}},
+		{Namespace: "platform", Version: "2.14.0"},
+	}
+
+	t.Run("should choose the operator installed in the target namespace", func(t *testing.T) {
+		opts := &ApplyOpts{GenerateOpts: GenerateOpts{targetNamespace: "tenant-b"}}
+		installation, err := opts.selectInstallation(installations)
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-b", installation.Namespace)
+	})
+
+	t.Run("should ask which operator to use when several watch the target namespace", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		opts := &ApplyOpts{GenerateOpts: GenerateOpts{targetNamespace: "shared"}, in: strings.NewReader("2\n"), promptOut: out}
+		installation, err := opts.selectInstallation(installations)
+		require.NoError(t, err)
+		assert.Equal(t, "platform", installation.Namespace)
+		assert.Contains(t, out.String(), "  1) tenant-b (2.14.0, watching tenant-b,shared)\n  2) platform (2.14.0, watching all namespaces)\n")
+	})
+
+	t.Run("should fail without a choice when input is not interactive", func(t *testing.T) {
+		opts := &ApplyOpts{in: strings.NewReader(""), promptOut: &bytes.Buffer{}}
+		_, err := opts.selectInstallation(installations)
+		require.ErrorContains(t, err, "set --targetNamespace to choose the operator")
+	})
+
+	t.Run("should fail when no operator watches the target namespace", func(t *testing.T) {
+		opts := &ApplyOpts{GenerateOpts: GenerateOpts{targetNamespace: "other"}}
+		_, err := opts.selectInstallation(installations[:2])
+		require.EqualError(t, err, "couldn't find an operator watching namespace other")
+	})
+}
@@ -28,6 +28,8 @@ func Builder() *cobra.Command {
 
 	cmd.AddCommand(GenerateBuilder())
 	cmd.AddCommand(ApplyBuilder())
+	cmd.AddCommand(DiffBuilder())
+	cmd.AddCommand(PushBuilder())
 
 	return cmd
 }
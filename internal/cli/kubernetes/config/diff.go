@@ -0,0 +1,138 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli/require"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var ErrDriftDetected = errors.New("drift detected between Kubernetes and Atlas")
+
+type DiffOpts struct {
+	ApplyOpts
+}
+
+func (opts *DiffOpts) Run() error {
+	configApply, err := opts.newConfigApply()
+	if err != nil {
+		return err
+	}
+
+	results, err := configApply.Diff()
+	if err != nil {
+		return err
+	}
+
+	if err = opts.Print(formatDiff(results)); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if result.Status != operator.DiffStatusUnchanged {
+			return ErrDriftDetected
+		}
+	}
+
+	return nil
+}
+
+func formatDiff(results []operator.DiffResult) string {
+	out := strings.Builder{}
+	drifted := 0
+	for _, result := range results {
+		if result.Status == operator.DiffStatusUnchanged {
+			continue
+		}
+		drifted++
+		out.WriteString(result.Diff)
+	}
+
+	if drifted == 0 {
+		out.WriteString("No drift detected between Kubernetes and Atlas")
+	} else {
+		fmt.Fprintf(&out, "%d of %d resources differ from Atlas", drifted, len(results))
+	}
+
+	return out.String()
+}
+
+// DiffBuilder builds a cobra.Command that can run as:
+// atlas kubernetes config diff --projectId=projectId --targetNamespace=my-namespace.
+func DiffBuilder() *cobra.Command {
+	const use = "diff"
+	opts := &DiffOpts{
+		ApplyOpts: ApplyOpts{
+			GenerateOpts: GenerateOpts{fs: afero.NewOsFs()},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Args:    require.NoArgs,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Compare Kubernetes resources in a cluster against the current state of Atlas.",
+		Long: `This command exports the configuration of Atlas objects and compares it with the matching resources in the Kubernetes cluster, printing a unified diff for each resource that differs.
+
+Only the fields set by the exported resources are compared, so finalizers, annotations, defaults set by the API server and fields managed by other tools are not reported as drift. Status, managed fields and the resource version label are ignored. Secret values are never printed, the diff only shows which keys of a Secret were added, removed or changed. The command exits with an error when any resource differs, so it can be used to detect drift in CI.`,
+		Example: `# Compare all supported resources of a specific project with the resources in the namespace of the installed operator:
+  atlas kubernetes config diff --projectId=<projectId>
+
+  # Compare all supported resources of a specific project with the resources in a specific namespace:
+  atlas kubernetes config diff --projectId=<projectId> --targetNamespace=<namespace>
+
+  # Compare auto-generated CRDs of a specific project:
+  atlas kubernetes config diff --projectId=<projectId> --targetNamespace=<namespace> --crdType=generated`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.OrgOpts.PreRunE(
+				opts.ValidateProjectID,
+				opts.ValidateTargetNamespace,
+				opts.ValidateOperatorVersion,
+				opts.initStores(cmd.Context()),
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts.in, opts.promptOut = cmd.InOrStdin(), cmd.ErrOrStderr()
+			return opts.Run()
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&opts.OrgID, flag.OrgID, "", usage.OrgID)
+	flags.StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	flags.StringSliceVar(&opts.clusterName, flag.ClusterName, []string{}, usage.ExporterClusterName)
+	flags.StringVar(&opts.targetNamespace, flag.OperatorTargetNamespace, "", usage.OperatorTargetNamespace)
+	flags.StringVar(&opts.operatorVersion, flag.OperatorVersion, "", usage.OperatorVersion)
+	flags.StringVar(&opts.KubeConfig, flag.KubernetesClusterConfig, "", usage.KubernetesClusterConfig)
+	flags.StringVar(&opts.KubeContext, flag.KubernetesClusterContext, "", usage.KubernetesClusterContext)
+	flags.StringSliceVar(&opts.dataFederationName, flag.DataFederationName, []string{}, usage.ExporterDataFederationName)
+	flags.BoolVar(&opts.independentResources, flag.IndependentResources, false, usage.IndependentResources)
+	flags.StringVar(&opts.crdType, flag.CRDType, features.CRDTypeCurated, usage.CRDType)
+	flags.IntVar(&opts.workers, flag.Workers, operator.DefaultMaxWorkers, usage.Workers)
+	flags.StringVar(&opts.crdsPath, flag.CRDsPath, "", usage.CRDsPath)
+
+	return cmd
+}
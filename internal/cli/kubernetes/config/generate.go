@@ -16,7 +16,12 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
@@ -26,15 +31,22 @@ import (
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var ErrUnsupportedOperatorVersionFmt = "version %q is not supported. Supported versions: %v"
 
+const generateOutputDirTemplate = `PATH	RESULT{{range .}}
+{{.Path}}	{{.Action}}{{end}}
+`
+
 type GenerateOpts struct {
 	cli.OrgOpts
 	cli.ProjectOpts
@@ -48,6 +60,22 @@ type GenerateOpts struct {
 	crdsProvider         crds.AtlasOperatorCRDProvider
 	independentResources bool
 	crdType              string
+	outputDir            string
+	allProjects          bool
+	projectNameFilter    string
+	projectTags          map[string]string
+	workers              int
+	include              []string
+	exclude              []string
+	resourceSelection    *features.ResourceSelection
+	crdsPath             string
+	format               string
+	secretsBackend       string
+	externalSecretStore  string
+	externalSecretsPath  string
+	sealedSecretsCert    string
+	secretsConverter     secrets.Converter
+	fs                   afero.Fs
 	profile              store.AuthenticatedConfig
 }
 
@@ -68,6 +96,84 @@ func (opts *GenerateOpts) ValidateOperatorVersion() error {
 	return fmt.Errorf(ErrUnsupportedOperatorVersionFmt, opts.operatorVersion, features.SupportedVersions())
 }
 
+// ValidateResourceSelection checks the kinds given to --include and --exclude against
+// the resources supported by the target operator version.
+func (opts *GenerateOpts) ValidateResourceSelection() error {
+	if len(opts.include) == 0 && len(opts.exclude) == 0 {
+		return nil
+	}
+
+	if opts.crdType == features.CRDTypeGenerated {
+		return fmt.Errorf("--%s and --%s options are not supported for generated CRDs", flag.Include, flag.Exclude)
+	}
+
+	selection, err := features.NewResourceSelection(opts.include, opts.exclude)
+	if err != nil {
+		return err
+	}
+
+	supported, _ := features.GetResourcesForVersion(opts.operatorVersion)
+	for _, kind := range opts.include {
+		resourceName, _ := features.ResourceForKind(kind)
+		if !slices.Contains(supported, resourceName) {
+			return fmt.Errorf("resource kind %q is not supported by operator version %s", kind, opts.operatorVersion)
+		}
+	}
+
+	opts.resourceSelection = selection
+	return nil
+}
+
+// ValidateOutputFormat checks the format given to --output against the output options it needs or conflicts with.
+func (opts *GenerateOpts) ValidateOutputFormat() error {
+	if !slices.Contains(operator.OutputFormats, opts.format) {
+		return fmt.Errorf("%s parameter is invalid: %q, valid values are %v", flag.Output, opts.format, operator.OutputFormats)
+	}
+
+	switch {
+	case opts.format == operator.OutputFormatJSON && opts.outputDir != "":
+		return fmt.Errorf("--%s=%s can not be used with --%s", flag.Output, opts.format, flag.OutputDir)
+	case opts.format == operator.OutputFormatHelm && opts.outputDir == "":
+		return fmt.Errorf("--%s=%s requires --%s", flag.Output, opts.format, flag.OutputDir)
+	case opts.format == operator.OutputFormatHelm && opts.allProjects:
+		return fmt.Errorf("--%s=%s can not be used with --%s", flag.Output, opts.format, flag.AllProjects)
+	}
+
+	return nil
+}
+
+// ValidateSecretsBackend checks the options of the secret store given to --secretsBackend and builds the
+// converter replacing exported Secrets.
+func (opts *GenerateOpts) ValidateSecretsBackend() error {
+	var err error
+
+	switch opts.secretsBackend {
+	case "":
+		return nil
+	case secrets.BackendExternalSecrets:
+		if opts.externalSecretStore == "" {
+			return fmt.Errorf("--%s=%s requires --%s", flag.SecretsBackend, opts.secretsBackend, flag.ExternalSecretStore)
+		}
+		opts.secretsConverter, err = secrets.NewExternalSecretConverter(opts.externalSecretStore, opts.externalSecretsPath)
+	case secrets.BackendSealedSecrets:
+		if opts.sealedSecretsCert == "" {
+			return fmt.Errorf("--%s=%s requires --%s", flag.SecretsBackend, opts.secretsBackend, flag.SealedSecretsCert)
+		}
+		if !opts.includeSecrets {
+			return fmt.Errorf("--%s=%s requires --%s", flag.SecretsBackend, opts.secretsBackend, flag.OperatorIncludeSecrets)
+		}
+		var publicKey []byte
+		if publicKey, err = afero.ReadFile(opts.fs, opts.sealedSecretsCert); err != nil {
+			return fmt.Errorf("failed to read %s: %w", opts.sealedSecretsCert, err)
+		}
+		opts.secretsConverter, err = secrets.NewSealedSecretConverter(publicKey)
+	default:
+		return fmt.Errorf("%s parameter is invalid: %q, valid values are %v", flag.SecretsBackend, opts.secretsBackend, secrets.Backends)
+	}
+
+	return err
+}
+
 func (opts *GenerateOpts) initStores(ctx context.Context) func() error {
 	return func() error {
 		var err error
@@ -78,69 +184,207 @@ func (opts *GenerateOpts) initStores(ctx context.Context) func() error {
 			return err
 		}
 
-		opts.crdsProvider = crds.NewGithubAtlasCRDProvider()
+		opts.crdsProvider, err = opts.newCRDsProvider()
+		return err
+	}
+}
 
-		return nil
+// newCRDsProvider reads CRDs from the path given with --crdsPath, or else from the
+// CRDs bundled with the plugin, downloading the ones missing from the bundle.
+func (opts *GenerateOpts) newCRDsProvider() (crds.AtlasOperatorCRDProvider, error) {
+	return newCRDsProvider(opts.fs, opts.crdsPath)
+}
+
+func newCRDsProvider(fs afero.Fs, crdsPath string) (crds.AtlasOperatorCRDProvider, error) {
+	if crdsPath == "" {
+		return crds.NewBundledAtlasCRDProvider(crds.NewGithubAtlasCRDProvider()), nil
 	}
+
+	return crds.NewLocalAtlasCRDProvider(fs, crdsPath)
+}
+
+// setupGeneratedExporter builds the exporter for auto-generated CRDs. Project and organization
+// are passed explicitly as commands embedding GenerateOpts carry their own project options.
+func (opts *GenerateOpts) setupGeneratedExporter(projectID, orgID, namespace string, includeSecrets bool) (*operator.GeneratedExporter, error) {
+	if len(opts.clusterName) > 0 {
+		return nil, fmt.Errorf("clusterName option is not supported for generated CRDs")
+	}
+
+	if len(opts.dataFederationName) > 0 {
+		return nil, fmt.Errorf("dataFederationName option is not supported for generated CRDs")
+	}
+
+	generatedExp, err := exporter.Setup(exporter.SetupConfig{
+		ProjectID:            projectID,
+		TargetNamespace:      namespace,
+		Profile:              opts.profile,
+		OrgID:                orgID,
+		CRDProvider:          opts.crdsProvider,
+		OperatorVersion:      opts.operatorVersion,
+		IndependentResources: opts.independentResources,
+		IncludeSecrets:       includeSecrets,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup generated exporter: %w", err)
+	}
+
+	return generatedExp, nil
+}
+
+// newExporter builds the exporter matching the requested CRD type for a single project,
+// replacing exported Secrets when a secrets backend is set.
+func (opts *GenerateOpts) newExporter(projectID, orgID, namespace string) (operator.Exporter, error) {
+	exp, err := opts.newCRDTypeExporter(projectID, orgID, namespace)
+	if err != nil || opts.secretsConverter == nil {
+		return exp, err
+	}
+
+	return operator.NewSecretsStoreExporter(exp, opts.secretsConverter), nil
+}
+
+func (opts *GenerateOpts) newCRDTypeExporter(projectID, orgID, namespace string) (operator.Exporter, error) {
+	if opts.crdType == features.CRDTypeGenerated {
+		return opts.setupGeneratedExporter(projectID, orgID, namespace, opts.includeSecrets)
+	}
+
+	// Use the existing curated exporter (legacy behavior)
+	atlasCRDs, err := features.NewAtlasCRDs(opts.crdsProvider, opts.operatorVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return operator.NewConfigExporter(opts.store, opts.profile, projectID, orgID).
+		WithClustersNames(opts.clusterName).
+		WithTargetNamespace(namespace).
+		WithSecretsData(opts.includeSecrets).
+		WithTargetOperatorVersion(opts.operatorVersion).
+		WithFeatureValidator(atlasCRDs).
+		WithPatcher(atlasCRDs).
+		WithDataFederationNames(opts.dataFederationName).
+		WithIndependentResources(opts.independentResources).
+		WithMaxWorkers(opts.workers).
+		WithResourceSelection(opts.resourceSelection), nil
 }
 
 func (opts *GenerateOpts) Run() error {
-	var exp operator.Exporter
+	if opts.allProjects {
+		return opts.runOrgWide()
+	}
+
+	exp, err := opts.newExporter(opts.ProjectID, opts.OrgID, opts.targetNamespace)
+	if err != nil {
+		return err
+	}
 
-	switch opts.crdType {
-	case features.CRDTypeGenerated:
-		if len(opts.clusterName) > 0 {
-			return fmt.Errorf("clusterName option is not supported for generated CRDs")
+	if opts.outputDir == "" && opts.format != operator.OutputFormatJSON {
+		result, err := exp.Run()
+		if err != nil {
+			return err
 		}
+		return opts.Print(result)
+	}
+
+	objects, err := exp.Export()
+	if err != nil {
+		return err
+	}
 
-		if len(opts.dataFederationName) > 0 {
-			return fmt.Errorf("dataFederationName option is not supported for generated CRDs")
+	if opts.outputDir == "" {
+		result, err := operator.Serialize(objects, opts.format)
+		if err != nil {
+			return err
 		}
+		return opts.Print(result)
+	}
+
+	var results []operator.ManifestResult
+	if opts.format == operator.OutputFormatHelm {
+		results, err = operator.NewHelmChartWriter(opts.fs, opts.outputDir).
+			WithAppVersion(opts.operatorVersion).
+			Write(objects)
+	} else {
+		results, err = operator.NewManifestWriter(opts.fs, opts.outputDir).Write(objects)
+	}
+	if err != nil {
+		return err
+	}
+
+	opts.Template = generateOutputDirTemplate
+	return opts.Print(results)
+}
+
+// runOrgWide exports every selected project of the organization to its own namespace,
+// and to its own directory when an output directory is set. JSON output gathers every
+// project in a single List.
+func (opts *GenerateOpts) runOrgWide() error {
+	output := strings.Builder{}
+	var results []operator.ManifestResult
+	var listed []runtime.Object
 
-		// Use the new generated exporter for auto-generated CRDs
-		generatedExp, err := exporter.Setup(exporter.SetupConfig{
-			ProjectID:            opts.ProjectID,
-			TargetNamespace:      opts.targetNamespace,
-			Profile:              opts.profile,
-			OrgID:                opts.OrgID,
-			CRDProvider:          opts.crdsProvider,
-			OperatorVersion:      opts.operatorVersion,
-			IndependentResources: opts.independentResources,
-			IncludeSecrets:       opts.includeSecrets,
-		})
+	err := opts.forEachOrgProject(opts.OrgID, func(project operator.OrgProject) error {
+		exp, err := opts.newExporter(project.ID, opts.OrgID, project.Namespace)
 		if err != nil {
-			return fmt.Errorf("failed to setup generated exporter: %w", err)
+			return err
 		}
-		exp = generatedExp
-	default:
-		// Use the existing curated exporter (legacy behavior)
-		atlasCRDs, err := features.NewAtlasCRDs(opts.crdsProvider, opts.operatorVersion)
+
+		objects, err := exp.Export()
 		if err != nil {
 			return err
 		}
-		exp = operator.NewConfigExporter(opts.store, opts.profile, opts.ProjectID, opts.OrgID).
-			WithClustersNames(opts.clusterName).
-			WithTargetNamespace(opts.targetNamespace).
-			WithSecretsData(opts.includeSecrets).
-			WithTargetOperatorVersion(opts.operatorVersion).
-			WithFeatureValidator(atlasCRDs).
-			WithPatcher(atlasCRDs).
-			WithDataFederationNames(opts.dataFederationName).
-			WithIndependentResources(opts.independentResources)
-	}
-
-	result, err := exp.Run()
-	if err != nil {
-		return err
+		objects = append([]runtime.Object{operator.NewNamespace(project.Namespace)}, objects...)
+
+		if opts.format == operator.OutputFormatJSON {
+			listed = append(listed, objects...)
+			return nil
+		}
+
+		if opts.outputDir == "" {
+			manifests, err := operator.SerializeObjects(objects)
+			if err != nil {
+				return err
+			}
+			output.WriteString(manifests)
+			return nil
+		}
+
+		projectResults, err := operator.NewManifestWriter(opts.fs, filepath.Join(opts.outputDir, project.Namespace)).
+			WithNamespaceDirs(false).
+			Write(objects)
+		if err != nil {
+			return err
+		}
+		for _, result := range projectResults {
+			result.Path = path.Join(project.Namespace, result.Path)
+			results = append(results, result)
+		}
+
+		return nil
+	})
+
+	var printErr error
+	switch {
+	case opts.format == operator.OutputFormatJSON:
+		var list string
+		if list, printErr = operator.SerializeList(listed); printErr == nil {
+			printErr = opts.Print(list)
+		}
+	case opts.outputDir != "":
+		opts.Template = generateOutputDirTemplate
+		printErr = opts.Print(results)
+	default:
+		printErr = opts.Print(output.String())
 	}
-	return opts.Print(result)
+
+	return errors.Join(err, printErr)
 }
 
 // GenerateBuilder builds a cobra.Command that can run as:
 // atlas kubernetes config generate --projectId=projectId --clusterName="cluster-1,cluster-2...cluster-N" --includeSecrets --targetNamespace=my-namespace.
 func GenerateBuilder() *cobra.Command {
 	const use = "generate"
-	opts := &GenerateOpts{}
+	opts := &GenerateOpts{
+		fs: afero.NewOsFs(),
+	}
 
 	cmd := &cobra.Command{
 		Use:     use,
@@ -167,13 +411,46 @@ func GenerateBuilder() *cobra.Command {
   atlas kubernetes config generate --projectId=<projectId> --targetNamespace=<namespace> --operatorVersion=1.5.1
 
   # Export Project, DatabaseUsers, Clusters and specific DataFederation resources for a specific project to a specific namespace:
-  atlas kubernetes config generate --projectId=<projectId> --dataFederationName=<data-federation-name-1, data-federation-name-2> --targetNamespace=<namespace>`,
+  atlas kubernetes config generate --projectId=<projectId> --dataFederationName=<data-federation-name-1, data-federation-name-2> --targetNamespace=<namespace>
+
+  # Export resources for a specific project to a directory, one file per resource along with a kustomization.yaml:
+  atlas kubernetes config generate --projectId=<projectId> --targetNamespace=<namespace> --outputDir=<directory>
+
+  # Export resources for a specific project as a single Kubernetes List in JSON:
+  atlas kubernetes config generate --projectId=<projectId> --targetNamespace=<namespace> -o json
+
+  # Export resources for a specific project as a Helm chart, with the namespace, secrets and cluster instance sizes as values:
+  atlas kubernetes config generate --projectId=<projectId> --targetNamespace=<namespace> --includeSecrets -o helm --outputDir=<directory>
+
+  # Export resources for a specific project referencing secrets stored in Vault through External Secrets Operator:
+  atlas kubernetes config generate --projectId=<projectId> --targetNamespace=<namespace> --secretsBackend=external-secrets --externalSecretStore=vault --externalSecretsPath="atlas/{{ .Namespace }}/{{ .Name }}"
+
+  # Export resources for a specific project with secrets sealed for Sealed Secrets:
+  atlas kubernetes config generate --projectId=<projectId> --targetNamespace=<namespace> --includeSecrets --secretsBackend=sealed-secrets --sealedSecretsCert=<cert.pem>
+
+  # Export resources for every project of an organization whose name starts with "prod-", each project to its own namespace and directory:
+  atlas kubernetes config generate --orgId=<orgId> --allProjects --projectNameFilter="prod-*" --outputDir=<directory>
+
+  # Export resources for every project of an organization tagged with environment=production:
+  atlas kubernetes config generate --orgId=<orgId> --allProjects --projectTag=environment=production
+
+  # Export only the deployments and database users of a specific project:
+  atlas kubernetes config generate --projectId=<projectId> --include=AtlasDeployment,AtlasDatabaseUser
+
+  # Export a specific project without organization-level resources:
+  atlas kubernetes config generate --projectId=<projectId> --exclude=AtlasOrgSettings,AtlasFederatedAuth,AtlasTeam
+
+  # Export resources for a specific project reading the operator CRDs from a local tarball:
+  atlas kubernetes config generate --projectId=<projectId> --operatorVersion=2.15.0 --crdsPath=<crds.tar.gz>`,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			return opts.OrgOpts.PreRunE(
 				opts.ValidateOrgID,
-				opts.ValidateProjectID,
+				opts.validateProjectSelection(&opts.ProjectOpts, &opts.OrgOpts),
 				opts.ValidateTargetNamespace,
 				opts.ValidateOperatorVersion,
+				opts.ValidateResourceSelection,
+				opts.ValidateOutputFormat,
+				opts.ValidateSecretsBackend,
 				opts.initStores(cmd.Context()),
 			)
 		},
@@ -191,5 +468,18 @@ func GenerateBuilder() *cobra.Command {
 	cmd.Flags().StringSliceVar(&opts.dataFederationName, flag.DataFederationName, []string{}, usage.ExporterDataFederationName)
 	cmd.Flags().BoolVar(&opts.independentResources, flag.IndependentResources, false, usage.IndependentResources)
 	cmd.Flags().StringVar(&opts.crdType, flag.CRDType, features.CRDTypeCurated, usage.CRDType)
+	cmd.Flags().StringVar(&opts.outputDir, flag.OutputDir, "", usage.OutputDir)
+	cmd.Flags().BoolVar(&opts.allProjects, flag.AllProjects, false, usage.AllProjects)
+	cmd.Flags().StringVar(&opts.projectNameFilter, flag.ProjectNameFilter, "", usage.ProjectNameFilter)
+	cmd.Flags().StringToStringVar(&opts.projectTags, flag.ProjectTag, nil, usage.ProjectTag)
+	cmd.Flags().IntVar(&opts.workers, flag.Workers, operator.DefaultMaxWorkers, usage.Workers)
+	cmd.Flags().StringSliceVar(&opts.include, flag.Include, []string{}, usage.Include)
+	cmd.Flags().StringSliceVar(&opts.exclude, flag.Exclude, []string{}, usage.Exclude)
+	cmd.Flags().StringVar(&opts.crdsPath, flag.CRDsPath, "", usage.CRDsPath)
+	cmd.Flags().StringVarP(&opts.format, flag.Output, flag.OutputShort, operator.OutputFormatYAML, usage.GenerateOutput)
+	cmd.Flags().StringVar(&opts.secretsBackend, flag.SecretsBackend, "", usage.SecretsBackend)
+	cmd.Flags().StringVar(&opts.externalSecretStore, flag.ExternalSecretStore, "", usage.ExternalSecretStore)
+	cmd.Flags().StringVar(&opts.externalSecretsPath, flag.ExternalSecretsPath, secrets.DefaultExternalSecretsPath, usage.ExternalSecretsPath)
+	cmd.Flags().StringVar(&opts.sealedSecretsCert, flag.SealedSecretsCert, "", usage.SealedSecretsCert)
 	return cmd
 }
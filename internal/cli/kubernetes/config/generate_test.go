@@ -19,6 +19,10 @@ package config
 import (
 	"testing"
 
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -74,3 +78,157 @@ func TestValidNamespace(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResourceSelection(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        *GenerateOpts
+		expectedErr string
+	}{
+		{
+			name: "No selection",
+			opts: &GenerateOpts{},
+		},
+		{
+			name: "Valid kinds",
+			opts: &GenerateOpts{
+				include: []string{"AtlasDeployment", "AtlasDatabaseUser"},
+				exclude: []string{"AtlasOrgSettings"},
+			},
+		},
+		{
+			name: "Unknown kind",
+			opts: &GenerateOpts{
+				exclude: []string{"AtlasCluster"},
+			},
+			expectedErr: `resource kind "AtlasCluster" is not supported. Supported kinds: [AtlasBackupCompliancePolicy AtlasBackupPolicy AtlasBackupSchedule AtlasCustomRole AtlasDataFederation AtlasDatabaseUser AtlasDeployment AtlasFederatedAuth AtlasIPAccessList AtlasNetworkContainer AtlasNetworkPeering AtlasOrgSettings AtlasPrivateEndpoint AtlasProject AtlasStreamConnection AtlasStreamInstance AtlasTeam AtlasThirdPartyIntegration]`,
+		},
+		{
+			name: "Generated CRDs",
+			opts: &GenerateOpts{
+				crdType: features.CRDTypeGenerated,
+				include: []string{"AtlasDeployment"},
+			},
+			expectedErr: "--include and --exclude options are not supported for generated CRDs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.operatorVersion = features.LatestOperatorMajorVersion
+			err := tt.opts.ValidateResourceSelection()
+
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        *GenerateOpts
+		expectedErr string
+	}{
+		{
+			name: "YAML",
+			opts: &GenerateOpts{format: operator.OutputFormatYAML},
+		},
+		{
+			name: "JSON",
+			opts: &GenerateOpts{format: operator.OutputFormatJSON, allProjects: true},
+		},
+		{
+			name: "Helm",
+			opts: &GenerateOpts{format: operator.OutputFormatHelm, outputDir: "chart"},
+		},
+		{
+			name:        "Unknown format",
+			opts:        &GenerateOpts{format: "xml"},
+			expectedErr: `output parameter is invalid: "xml", valid values are [yaml json helm]`,
+		},
+		{
+			name:        "JSON to a directory",
+			opts:        &GenerateOpts{format: operator.OutputFormatJSON, outputDir: "manifests"},
+			expectedErr: "--output=json can not be used with --outputDir",
+		},
+		{
+			name:        "Helm without directory",
+			opts:        &GenerateOpts{format: operator.OutputFormatHelm},
+			expectedErr: "--output=helm requires --outputDir",
+		},
+		{
+			name:        "Helm for every project",
+			opts:        &GenerateOpts{format: operator.OutputFormatHelm, outputDir: "chart", allProjects: true},
+			expectedErr: "--output=helm can not be used with --allProjects",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.ValidateOutputFormat()
+
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateSecretsBackend(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        *GenerateOpts
+		expectedErr string
+	}{
+		{
+			name: "Plain secrets",
+			opts: &GenerateOpts{},
+		},
+		{
+			name: "External secrets",
+			opts: &GenerateOpts{secretsBackend: secrets.BackendExternalSecrets, externalSecretStore: "vault", externalSecretsPath: secrets.DefaultExternalSecretsPath},
+		},
+		{
+			name:        "External secrets without store",
+			opts:        &GenerateOpts{secretsBackend: secrets.BackendExternalSecrets},
+			expectedErr: "--secretsBackend=external-secrets requires --externalSecretStore",
+		},
+		{
+			name:        "Sealed secrets without certificate",
+			opts:        &GenerateOpts{secretsBackend: secrets.BackendSealedSecrets, includeSecrets: true},
+			expectedErr: "--secretsBackend=sealed-secrets requires --sealedSecretsCert",
+		},
+		{
+			name:        "Sealed secrets without secrets data",
+			opts:        &GenerateOpts{secretsBackend: secrets.BackendSealedSecrets, sealedSecretsCert: "cert.pem"},
+			expectedErr: "--secretsBackend=sealed-secrets requires --includeSecrets",
+		},
+		{
+			name:        "Sealed secrets with a missing certificate",
+			opts:        &GenerateOpts{secretsBackend: secrets.BackendSealedSecrets, sealedSecretsCert: "cert.pem", includeSecrets: true},
+			expectedErr: "failed to read cert.pem: open cert.pem: file does not exist",
+		},
+		{
+			name:        "Unknown backend",
+			opts:        &GenerateOpts{secretsBackend: "vault"},
+			expectedErr: `secretsBackend parameter is invalid: "vault", valid values are [external-secrets sealed-secrets]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.fs = afero.NewMemMapFs()
+			err := tt.opts.ValidateSecretsBackend()
+
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.opts.secretsBackend != "", tt.opts.secretsConverter != nil)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
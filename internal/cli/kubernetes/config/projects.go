@@ -0,0 +1,92 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
+)
+
+// validateProjectSelection checks that either a single project or, with --allProjects, the projects
+// of an organization are selected. Project and organization options are passed explicitly as commands
+// embedding GenerateOpts carry their own.
+func (opts *GenerateOpts) validateProjectSelection(projectOpts *cli.ProjectOpts, orgOpts *cli.OrgOpts) func() error {
+	return func() error {
+		if !opts.allProjects {
+			if opts.projectNameFilter != "" || len(opts.projectTags) > 0 {
+				return fmt.Errorf("--%s and --%s can only be used with --%s", flag.ProjectNameFilter, flag.ProjectTag, flag.AllProjects)
+			}
+
+			return projectOpts.ValidateProjectID()
+		}
+
+		if projectOpts.ProjectID != "" {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", flag.ProjectID, flag.AllProjects)
+		}
+
+		if opts.targetNamespace != "" {
+			return fmt.Errorf("--%s can not be used with --%s, every project is exported to its own namespace", flag.OperatorTargetNamespace, flag.AllProjects)
+		}
+
+		if len(opts.clusterName) > 0 || len(opts.dataFederationName) > 0 {
+			return fmt.Errorf("--%s and --%s can not be used with --%s", flag.ClusterName, flag.DataFederationName, flag.AllProjects)
+		}
+
+		if err := operator.ValidateProjectFilter(opts.projectFilter()); err != nil {
+			return err
+		}
+
+		return orgOpts.ValidateOrgID()
+	}
+}
+
+func (opts *GenerateOpts) projectFilter() operator.ProjectFilter {
+	return operator.ProjectFilter{
+		NameGlob: opts.projectNameFilter,
+		Tags:     opts.projectTags,
+	}
+}
+
+// forEachOrgProject runs fn for every selected project of the organization. A project failing
+// does not stop the others, the failures are reported together once all projects were processed.
+func (opts *GenerateOpts) forEachOrgProject(orgID string, fn func(project operator.OrgProject) error) error {
+	projects, err := operator.ListOrgProjects(opts.store, orgID, opts.projectFilter())
+	if err != nil {
+		return err
+	}
+
+	if len(projects) == 0 {
+		return fmt.Errorf("no project of organization %s matches the given filters", orgID)
+	}
+
+	var errs []error
+	for _, project := range projects {
+		if err := fn(project); err != nil {
+			_, _ = log.Warningf("failed to export project %s (%s): %v\n", project.Name, project.ID, err)
+			errs = append(errs, fmt.Errorf("project %s (%s): %w", project.Name, project.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to export %d of %d projects: %w", len(errs), len(projects), errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+)
+
+const (
+	testOrgID     = "5e2211c17a3e5a48f5497de3"
+	testProjectID = "5e2211c17a3e5a48f5497de4"
+)
+
+func TestValidateProjectSelection(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        *GenerateOpts
+		projectID   string
+		expectedErr string
+	}{
+		{
+			name:      "single project",
+			opts:      &GenerateOpts{},
+			projectID: testProjectID,
+		},
+		{
+			name: "filters without all projects",
+			opts: &GenerateOpts{
+				projectNameFilter: "prod-*",
+			},
+			projectID:   testProjectID,
+			expectedErr: "--projectNameFilter and --projectTag can only be used with --allProjects",
+		},
+		{
+			name: "all projects",
+			opts: &GenerateOpts{
+				allProjects:       true,
+				projectNameFilter: "prod-*",
+				projectTags:       map[string]string{"environment": "production"},
+			},
+		},
+		{
+			name: "all projects with a project",
+			opts: &GenerateOpts{
+				allProjects: true,
+			},
+			projectID:   testProjectID,
+			expectedErr: "--projectId and --allProjects are mutually exclusive",
+		},
+		{
+			name: "all projects with a target namespace",
+			opts: &GenerateOpts{
+				allProjects:     true,
+				targetNamespace: "my-namespace",
+			},
+			expectedErr: "--targetNamespace can not be used with --allProjects, every project is exported to its own namespace",
+		},
+		{
+			name: "all projects with a cluster",
+			opts: &GenerateOpts{
+				allProjects: true,
+				clusterName: []string{"my-cluster"},
+			},
+			expectedErr: "--clusterName and --dataFederationName can not be used with --allProjects",
+		},
+		{
+			name: "all projects with a malformed pattern",
+			opts: &GenerateOpts{
+				allProjects:       true,
+				projectNameFilter: "prod-[",
+			},
+			expectedErr: "invalid project name pattern \"prod-[\": syntax error in pattern",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectOpts := &cli.ProjectOpts{ProjectID: tt.projectID}
+			orgOpts := &cli.OrgOpts{OrgID: testOrgID}
+
+			err := tt.opts.validateProjectSelection(projectOpts, orgOpts)()
+
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestForEachOrgProject(t *testing.T) {
+	ctl := gomock.NewController(t)
+	store := mocks.NewMockOperatorGenericStore(ctl)
+	store.EXPECT().AllOrgProjects(testOrgID).Return([]admin.Group{
+		{Id: pointer.Get("1"), Name: "project-a"},
+		{Id: pointer.Get("2"), Name: "project-b"},
+		{Id: pointer.Get("3"), Name: "project-c"},
+	}, nil)
+
+	opts := &GenerateOpts{allProjects: true, store: store}
+
+	var processed []string
+	err := opts.forEachOrgProject(testOrgID, func(project operator.OrgProject) error {
+		processed = append(processed, project.Namespace)
+		if project.ID == "2" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	assert.Equal(t, []string{"project-a", "project-b", "project-c"}, processed)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "failed to export 1 of 3 projects")
+	assert.ErrorContains(t, err, "project project-b (2): boom")
+}
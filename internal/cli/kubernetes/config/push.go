@@ -0,0 +1,175 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli/require"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/push"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const pushTemplate = `KIND	NAME	ACTION	CALL{{range .Steps}}
+{{.Kind}}	{{.Name}}	{{.Action}}	{{if .Call}}{{.Call}}{{else}}{{.Reason}}{{end}}{{end}}
+`
+
+type PushOpts struct {
+	cli.OrgOpts
+	cli.OutputOpts
+	file            string
+	force           bool
+	operatorVersion string
+	crdsPath        string
+	fs              afero.Fs
+	pusher          *push.Pusher
+}
+
+func (opts *PushOpts) ValidateOperatorVersion() error {
+	if _, versionFound := features.GetResourcesForVersion(opts.operatorVersion); versionFound {
+		return nil
+	}
+	return fmt.Errorf(ErrUnsupportedOperatorVersionFmt, opts.operatorVersion, features.SupportedVersions())
+}
+
+func (opts *PushOpts) initPusher() error {
+	sdkClient, err := exporter.NewSDKClient(config.Default())
+	if err != nil {
+		return fmt.Errorf("failed to create SDK client: %w", err)
+	}
+
+	scheme, err := exporter.NewScheme()
+	if err != nil {
+		return fmt.Errorf("failed to create scheme: %w", err)
+	}
+
+	crdsProvider, err := newCRDsProvider(opts.fs, opts.crdsPath)
+	if err != nil {
+		return err
+	}
+
+	translators, err := exporter.NewTranslators(scheme, crdsProvider, opts.operatorVersion)
+	if err != nil {
+		return err
+	}
+
+	opts.pusher = push.NewPusher(sdkClient, scheme, translators).WithOrgID(opts.ConfigOrgID())
+	return nil
+}
+
+func (opts *PushOpts) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	manifests, err := push.ReadManifests(opts.fs, opts.file)
+	if err != nil {
+		return err
+	}
+
+	plan, err := opts.pusher.Plan(ctx, manifests)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.Print(plan); err != nil {
+		return err
+	}
+
+	changes := countChanges(plan)
+	if changes == 0 {
+		_, err := fmt.Fprintln(out, "Nothing to push to Atlas")
+		return err
+	}
+
+	if !opts.force {
+		confirmed, err := cli.Confirm(in, out, fmt.Sprintf("Push %d changes to Atlas?", changes))
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	if err := opts.pusher.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "Pushed %d changes to Atlas\n", changes)
+	return err
+}
+
+func countChanges(plan *push.Plan) int {
+	changes := 0
+	for _, step := range plan.Steps {
+		if step.Action != push.ActionSkip {
+			changes++
+		}
+	}
+
+	return changes
+}
+
+// PushBuilder builds a cobra.Command that can run as:
+// atlas kubernetes config push -f ./manifests.
+func PushBuilder() *cobra.Command {
+	const use = "push"
+	opts := &PushOpts{fs: afero.NewOsFs()}
+	opts.Template = pushTemplate
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Args:    require.NoArgs,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Create or update Atlas resources from Kubernetes manifests of curated or auto-generated CRDs.",
+		Long: `This command reads Kubernetes manifests, such as the ones written by config generate, and creates or updates the matching Atlas resources through the Atlas Admin API, without an operator running.
+
+The Atlas Admin API calls to make are listed first and only made once confirmed. Projects are pushed first, so the other resources can reference them with projectRef or groupRef. Secrets in the manifests are used to resolve references, such as database user passwords.
+
+Of the curated CRDs, AtlasProject, AtlasDeployment, AtlasDatabaseUser and AtlasIPAccessList resources are pushed. Projects are created in the organization set with --orgId or in the profile, and only the project itself is pushed, not the settings or sub-resources of its spec. Serverless deployments and other kinds are listed as skipped.`,
+		Example: `# Show the Atlas Admin API calls needed to push the manifests of a directory, and make them once confirmed:
+  atlas kubernetes config push -f <directory>
+
+  # Push a single manifest file without asking for confirmation:
+  atlas kubernetes config push -f <file> --force
+
+  # Push manifests reading the operator CRDs from a local tarball:
+  atlas kubernetes config push -f <directory> --operatorVersion=2.15.0 --crdsPath=<crds.tar.gz>`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.ValidateOperatorVersion(); err != nil {
+				return err
+			}
+			return opts.initPusher()
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context(), cmd.InOrStdin(), cmd.ErrOrStderr())
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVarP(&opts.file, flag.File, flag.FileShort, "", usage.PushFile)
+	opts.AddOrgOptFlags(cmd)
+	flags.BoolVar(&opts.force, flag.Force, false, usage.Force)
+	flags.StringVar(&opts.operatorVersion, flag.OperatorVersion, features.LatestOperatorMajorVersion, usage.OperatorVersion)
+	flags.StringVar(&opts.crdsPath, flag.CRDsPath, "", usage.CRDsPath)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+
+	return cmd
+}
@@ -27,24 +27,38 @@
 package dryrun
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli/require"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/util/validation"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
 var ErrUnsupportedOperatorVersionFmt = "version %q is not supported. Supported versions: %v"
 
 const defaultTimeoutSec = 120
 
+const cloudGovAtlasDomain = "https://cloud.mongodbgov.com/"
+
+const reportTemplate = `KIND	NAMESPACE	NAME	ACTION	METHOD	PATH{{range .Actions}}
+{{.Kind}}	{{.Namespace}}	{{.Name}}	{{.Action}}	{{.Method}}	{{.Path}}{{end}}
+`
+
 type Opts struct {
 	cli.OrgOpts
 	cli.OutputOpts
@@ -54,6 +68,9 @@ type Opts struct {
 	watchNamespaces []string
 	waitForJob      bool
 	waitTimeout     int64
+	imageRegistry   string
+	kubeConfig      string
+	kubeContext     string
 }
 
 func (opts *Opts) ValidateTargetNamespace() error {
@@ -70,12 +87,15 @@ func (opts *Opts) ValidateOperatorVersion() error {
 	return fmt.Errorf(ErrUnsupportedOperatorVersionFmt, opts.operatorVersion, features.SupportedVersions())
 }
 
-func (opts *Opts) MakeK8SClient() (client.Client, error) {
-	conf, err := config.GetConfig()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get k8s config: %w", err)
+// ValidateOutput checks that the report is waited for, as it is only available once the job completed.
+func (opts *Opts) ValidateOutput() error {
+	if opts.Output != "" && !opts.waitForJob {
+		return errors.New("--" + flag.Output + " requires --" + flag.EnableWatch + ", the report is read from the logs of the completed job")
 	}
+	return nil
+}
 
+func (opts *Opts) MakeK8SClient(conf *rest.Config) (client.Client, error) {
 	c, err := client.New(conf, client.Options{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
@@ -83,20 +103,77 @@ func (opts *Opts) MakeK8SClient() (client.Client, error) {
 	return c, nil
 }
 
-func (opts *Opts) Run() error {
-	k8sClient, err := opts.MakeK8SClient()
+func (opts *Opts) MakeLogStreamer(conf *rest.Config) (PodLogStreamer, error) {
+	pods, err := clientset.NewForConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return NewPodLogStreamer(pods.CoreV1()), nil
+}
+
+func (opts *Opts) Run(ctx context.Context) error {
+	kubeCtl, err := kubernetes.NewKubeCtl(opts.kubeConfig, opts.kubeContext)
 	if err != nil {
 		return err
 	}
 
+	k8sClient, err := opts.MakeK8SClient(kubeCtl.RESTConfig())
+	if err != nil {
+		return err
+	}
+
+	logs, err := opts.MakeLogStreamer(kubeCtl.RESTConfig())
+	if err != nil {
+		return err
+	}
+
+	// the job runs with the service account of the operator, so it has the operator permissions
+	installation, err := operator.FindInstallationFor(ctx, kubeCtl, opts.targetNamespace)
+	if err != nil {
+		return fmt.Errorf("the dry-run job runs with the service account of the operator watching the target namespace: %w", err)
+	}
+
 	worker := NewWorker().
 		WithTargetNamespace(opts.targetNamespace).
+		WithJobNamespace(installation.Namespace).
 		WithWatchNamespaces(strings.Join(opts.watchNamespaces, ",")).
 		WithOperatorVersion(opts.operatorVersion).
 		WithWaitForCompletion(opts.waitForJob).
 		WithWaitTimeoutSec(opts.waitTimeout).
-		WithK8SClient(k8sClient)
-	return worker.Run()
+		WithAtlasDomain(AtlasDomain(config.Default())).
+		WithImageRegistry(opts.imageRegistry).
+		WithServiceAccount(ServiceAccount(installation)).
+		WithK8SClient(k8sClient).
+		WithLogStreamer(logs).
+		WithProgressWriter(os.Stderr)
+
+	report, err := worker.Run()
+	if err != nil || report == nil {
+		return err
+	}
+
+	return opts.Print(report)
+}
+
+// AtlasDomain returns the Atlas domain of the profile, chosen the way the Atlas client chooses its base URL.
+func AtlasDomain(profile store.ServiceGetter) string {
+	if url := profile.OpsManagerURL(); url != "" {
+		return url
+	}
+	if profile.Service() == config.CloudGovService {
+		return cloudGovAtlasDomain
+	}
+	return DefaultAtlasDomain
+}
+
+// ServiceAccount returns the service account of an operator. It is empty when the operator runs with the
+// default service account of its namespace, which the job then runs with as well.
+func ServiceAccount(installation *operator.Installation) string {
+	if installation.Deployment == nil {
+		return ""
+	}
+
+	return installation.Deployment.Spec.Template.Spec.ServiceAccountName
 }
 
 // Builder builds a cobra.Command for the Kubernetes dryrun installation.
@@ -104,21 +181,35 @@ func Builder() *cobra.Command {
 	const use = "dry-run"
 
 	opts := &Opts{}
+	opts.Template = reportTemplate
 
 	cmd := &cobra.Command{
 		Use:     use,
 		Args:    require.NoArgs,
 		Aliases: cli.GenerateAliases(use),
 		Short:   "Deploy and run Atlas Kubernetes Operator in dry-run mode",
-		Long:    `This command deploys the Atlas Kubernetes operator with the DryRun mode.`,
+		Long: `This command deploys the Atlas Kubernetes operator with the DryRun mode.
+
+The operator reads Atlas from the domain of the current profile, and runs with the service account of the operator watching the target namespace, in the namespace the operator is installed in. The command fails when no operator watches the target namespace.
+
+With --watch, the command waits for the dry-run job to complete, then reads the logs of its pod and prints the requests the operator would send to Atlas, by custom resource. Use --output=json to print them as JSON.`,
+		Example: `# Show the changes the operator would make in Atlas for the resources of a namespace:
+  atlas kubernetes dry-run --targetNamespace=<namespace> --watch
+
+  # Print the planned changes as JSON:
+  atlas kubernetes dry-run --targetNamespace=<namespace> --watch --output=json
+
+  # Run the operator image of a registry mirror, in another cluster of the kubeconfig:
+  atlas kubernetes dry-run --targetNamespace=<namespace> --imageRegistry=<registry> --kubeContext=<context>`,
 		PreRunE: func(_ *cobra.Command, _ []string) error {
 			return opts.PreRunE(
 				opts.ValidateTargetNamespace,
 				opts.ValidateOperatorVersion,
+				opts.ValidateOutput,
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -128,5 +219,9 @@ func Builder() *cobra.Command {
 	cmd.Flags().StringVar(&opts.operatorVersion, flag.OperatorVersion, features.LatestOperatorMajorVersion, usage.OperatorVersion)
 	cmd.Flags().BoolVar(&opts.waitForJob, flag.EnableWatch, false, usage.EnableWatch)
 	cmd.Flags().Int64Var(&opts.waitTimeout, flag.WatchTimeout, defaultTimeoutSec, usage.WatchTimeout)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.DryRunOutput)
+	cmd.Flags().StringVar(&opts.imageRegistry, flag.ImageRegistry, DefaultImageRegistry, usage.DryRunImageRegistry)
+	cmd.Flags().StringVar(&opts.kubeConfig, flag.KubernetesClusterConfig, "", usage.KubernetesClusterConfig)
+	cmd.Flags().StringVar(&opts.kubeContext, flag.KubernetesClusterContext, "", usage.KubernetesClusterContext)
 	return cmd
 }
@@ -0,0 +1,59 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package dryrun
+
+import (
+	"testing"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type testProfile struct {
+	service       string
+	opsManagerURL string
+}
+
+func (p *testProfile) Service() string       { return p.service }
+func (p *testProfile) OpsManagerURL() string { return p.opsManagerURL }
+
+func TestAtlasDomain(t *testing.T) {
+	assert.Equal(t, DefaultAtlasDomain, AtlasDomain(&testProfile{service: config.CloudService}))
+	assert.Equal(t, "https://cloud.mongodbgov.com/", AtlasDomain(&testProfile{service: config.CloudGovService}))
+	assert.Equal(t, "https://cloud-qa.mongodb.com/", AtlasDomain(&testProfile{
+		service:       config.CloudGovService,
+		opsManagerURL: "https://cloud-qa.mongodb.com/",
+	}))
+}
+
+func TestServiceAccount(t *testing.T) {
+	assert.Equal(t, "apps-operator", ServiceAccount(&operator.Installation{Namespace: "apps", Deployment: operatorDeployment("apps-operator")}))
+	assert.Empty(t, ServiceAccount(&operator.Installation{Namespace: "legacy", Deployment: operatorDeployment("")}))
+}
+
+func operatorDeployment(serviceAccount string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{ServiceAccountName: serviceAccount},
+			},
+		},
+	}
+}
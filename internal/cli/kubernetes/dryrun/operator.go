@@ -28,7 +28,11 @@ package dryrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
@@ -37,6 +41,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -44,18 +49,58 @@ const repeatInterval = 5 * time.Second
 const liveProbePort = 8081
 const initialDelaySeconds = 15
 const periodSeconds = 20
+const containerName = "ako-dry-run"
+
+const (
+	DefaultAtlasDomain    = "https://cloud.mongodb.com/"
+	DefaultImageRegistry  = "quay.io"
+	DefaultServiceAccount = "mongodb-atlas-operator"
+
+	operatorImage = "mongodb/atlas-kubernetes-operator"
+)
+
+// jobNameLabel is set by Kubernetes on the pods of a job.
+const jobNameLabel = "job-name"
+
+// PodLogStreamer streams the logs of the dry-run container of a pod.
+type PodLogStreamer interface {
+	StreamLogs(ctx context.Context, namespace, name string) (io.ReadCloser, error)
+}
+
+type podLogStreamer struct {
+	pods corev1client.PodsGetter
+}
+
+func NewPodLogStreamer(pods corev1client.PodsGetter) PodLogStreamer {
+	return &podLogStreamer{pods: pods}
+}
+
+func (s *podLogStreamer) StreamLogs(ctx context.Context, namespace, name string) (io.ReadCloser, error) {
+	return s.pods.Pods(namespace).GetLogs(name, &corev1.PodLogOptions{Container: containerName}).Stream(ctx)
+}
 
 type Worker struct {
 	targetNamespace string
+	jobNamespace    string
 	watchNamespaces string
 	wait            bool
 	akoVersion      string
 	waitSec         int64
+	atlasDomain     string
+	imageRegistry   string
+	serviceAccount  string
 	k8sClient       client.Client
+	logs            PodLogStreamer
+	progress        io.Writer
 }
 
 func NewWorker() *Worker {
-	return &Worker{}
+	return &Worker{
+		atlasDomain:    DefaultAtlasDomain,
+		imageRegistry:  DefaultImageRegistry,
+		serviceAccount: DefaultServiceAccount,
+		progress:       os.Stdout,
+	}
 }
 
 func (r *Worker) WithTargetNamespace(targetNamespace string) *Worker {
@@ -63,6 +108,13 @@ func (r *Worker) WithTargetNamespace(targetNamespace string) *Worker {
 	return r
 }
 
+// WithJobNamespace sets the namespace the job runs in, which is the target namespace by default. The job
+// has to run in the namespace of its service account.
+func (r *Worker) WithJobNamespace(jobNamespace string) *Worker {
+	r.jobNamespace = jobNamespace
+	return r
+}
+
 func (r *Worker) WithWatchNamespaces(watchNamespaces string) *Worker {
 	r.watchNamespaces = watchNamespaces
 	return r
@@ -83,12 +135,54 @@ func (r *Worker) WithWaitTimeoutSec(waitSec int64) *Worker {
 	return r
 }
 
+// WithAtlasDomain sets the Atlas domain the operator reads the Atlas resources from.
+func (r *Worker) WithAtlasDomain(atlasDomain string) *Worker {
+	r.atlasDomain = atlasDomain
+	return r
+}
+
+// WithImageRegistry sets the registry the operator image is pulled from, e.g. a mirror of quay.io.
+func (r *Worker) WithImageRegistry(imageRegistry string) *Worker {
+	r.imageRegistry = imageRegistry
+	return r
+}
+
+// WithServiceAccount sets the service account the job runs with, which needs the permissions of the operator.
+func (r *Worker) WithServiceAccount(serviceAccount string) *Worker {
+	r.serviceAccount = serviceAccount
+	return r
+}
+
 func (r *Worker) WithK8SClient(k8sClient client.Client) *Worker {
 	r.k8sClient = k8sClient
 	return r
 }
 
-func (r *Worker) Run() error {
+// WithLogStreamer sets how the logs of the job pod are read to build the report.
+func (r *Worker) WithLogStreamer(logs PodLogStreamer) *Worker {
+	r.logs = logs
+	return r
+}
+
+// WithProgressWriter sets where the progress of the job is written, so the report can be printed alone.
+func (r *Worker) WithProgressWriter(progress io.Writer) *Worker {
+	r.progress = progress
+	return r
+}
+
+// Run starts the dry-run job. When waiting for its completion, it returns the changes the operator
+// would make in Atlas, as reported in the logs of the job pod.
+func (r *Worker) Run() (*Report, error) {
+	watchNamespaces := r.watchNamespaces
+	if watchNamespaces == "" {
+		watchNamespaces = r.targetNamespace
+	}
+
+	jobNamespace := r.jobNamespace
+	if jobNamespace == "" {
+		jobNamespace = r.targetNamespace
+	}
+
 	jb := &batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Job",
@@ -97,21 +191,21 @@ func (r *Worker) Run() error {
 		ObjectMeta: metav1.ObjectMeta{
 			Labels:       map[string]string{"app": "ako-dry-run"},
 			GenerateName: "ako-dry-run",
-			Namespace:    r.targetNamespace,
+			Namespace:    jobNamespace,
 		},
 		Spec: batchv1.JobSpec{
 			BackoffLimit: pointer.Get[int32](1),
 			Template: corev1.PodTemplateSpec{
 				Spec: corev1.PodSpec{
-					ServiceAccountName: "mongodb-atlas-operator",
+					ServiceAccountName: r.serviceAccount,
 					RestartPolicy:      corev1.RestartPolicyNever,
 					Containers: []corev1.Container{
 						{
-							Name:    "ako-dry-run",
-							Image:   "quay.io/mongodb/atlas-kubernetes-operator:" + r.akoVersion,
+							Name:    containerName,
+							Image:   strings.TrimSuffix(r.imageRegistry, "/") + "/" + operatorImage + ":" + r.akoVersion,
 							Command: []string{"/manager"},
 							Args: []string{
-								"--atlas-domain=https://cloud-qa.mongodb.com/",
+								"--atlas-domain=" + r.atlasDomain,
 								"--log-level=info",
 								"--log-encoder=json",
 								"--dry-run",
@@ -123,11 +217,11 @@ func (r *Worker) Run() error {
 								},
 								{
 									Name:  "OPERATOR_NAMESPACE",
-									Value: r.targetNamespace,
+									Value: jobNamespace,
 								},
 								{
 									Name:  "WATCH_NAMESPACE",
-									Value: r.targetNamespace,
+									Value: watchNamespaces,
 								},
 								{
 									Name:  "JOB_NAME",
@@ -153,29 +247,79 @@ func (r *Worker) Run() error {
 	}
 
 	if err := r.k8sClient.Create(context.Background(), jb); err != nil {
-		return fmt.Errorf("failed to create job: %w", err)
+		return nil, fmt.Errorf("failed to create job: %w", err)
 	}
 
-	fmt.Printf("AKO dry run job '%s' created successfully at '%s'\r\n",
+	_, _ = fmt.Fprintf(r.progress, "AKO dry run job '%s' created successfully at '%s'\r\n",
 		jb.Name, jb.CreationTimestamp.Format(time.DateTime))
 
 	if !r.wait {
-		return nil
+		return nil, nil
 	}
 
 	ctx, timeoutF := context.WithTimeout(context.Background(), time.Duration(r.waitSec)*time.Second)
 	defer timeoutF()
 
-	if err := waitForJob(ctx, r.k8sClient, jb); err != nil {
-		return fmt.Errorf("failed to wait for job: %w", err)
+	if err := waitForJob(ctx, r.k8sClient, jb, r.progress); err != nil {
+		return nil, fmt.Errorf("failed to wait for job: %w", err)
 	}
 
-	fmt.Printf("AKO dry run job '%s' completed successfully at '%s'\r\n",
+	_, _ = fmt.Fprintf(r.progress, "AKO dry run job '%s' completed successfully at '%s'\r\n",
 		jb.Name, time.Now().Format(time.DateTime))
-	return nil
+
+	return r.report(context.Background(), jb)
+}
+
+// report parses the logs of the pod which ran the job.
+func (r *Worker) report(ctx context.Context, job *batchv1.Job) (*Report, error) {
+	if r.logs == nil {
+		return nil, errors.New("failed to read the dry-run logs: no log streamer set")
+	}
+
+	pod, err := jobPod(ctx, r.k8sClient, job)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := r.logs.StreamLogs(ctx, pod.Namespace, pod.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream the logs of pod %s: %w", pod.Name, err)
+	}
+	defer logs.Close()
+
+	actions, err := ParseLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{Job: job.Name, Actions: actions}, nil
+}
+
+// jobPod returns the pod which completed the job or, when it was retried, the last one started.
+func jobPod(ctx context.Context, c client.Client, job *batchv1.Job) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{jobNameLabel: job.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list the pods of job %s: %w", job.Name, err)
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("failed to find the pod of job %s", job.Name)
+	}
+
+	var result *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded {
+			return pod, nil
+		}
+		if result == nil || result.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			result = pod
+		}
+	}
+
+	return result, nil
 }
 
-func waitForJob(ctx context.Context, c client.Client, job *batchv1.Job) error {
+func waitForJob(ctx context.Context, c client.Client, job *batchv1.Job, progress io.Writer) error {
 	attempts := 0
 	for {
 		select {
@@ -197,7 +341,7 @@ func waitForJob(ctx context.Context, c client.Client, job *batchv1.Job) error {
 
 			time.Sleep(repeatInterval)
 			attempts++
-			fmt.Printf("Waiting for job to complete... Attempt #%d\r\n", attempts)
+			_, _ = fmt.Fprintf(progress, "Waiting for job to complete... Attempt #%d\r\n", attempts)
 		}
 	}
 }
@@ -28,11 +28,16 @@ package dryrun
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -41,16 +46,29 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 )
 
+type stubLogStreamer struct {
+	logs string
+}
+
+func (s *stubLogStreamer) StreamLogs(_ context.Context, _, _ string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(s.logs)), nil
+}
+
 func TestWorkerRunSuccess(t *testing.T) {
 	schm := scheme.Scheme
 	assert.NoError(t, akov2.AddToScheme(schm))
 	k8sClient := fake.NewClientBuilder().WithScheme(schm).WithRuntimeObjects().Build()
+	logs := &stubLogStreamer{
+		logs: `{"level":"ERROR","msg":"failed to create project","atlasproject":"test/my-project","error":"DryRun event: Would create (POST) /api/atlas/v2/groups"}` + "\n",
+	}
 	worker := NewWorker().WithK8SClient(k8sClient).
 		WithTargetNamespace("test").
 		WithWatchNamespaces("test").
 		WithOperatorVersion(features.LatestOperatorMajorVersion).
 		WithWaitTimeoutSec(10).
-		WithWaitForCompletion(true)
+		WithWaitForCompletion(true).
+		WithLogStreamer(logs).
+		WithProgressWriter(io.Discard)
 	go func() {
 		attempts := 3
 		delay := 500 * time.Millisecond
@@ -66,12 +84,24 @@ func TestWorkerRunSuccess(t *testing.T) {
 				continue
 			}
 			job := jobs.Items[0]
+			assert.NoError(t, k8sClient.Create(context.Background(), &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      job.Name + "-pod",
+					Namespace: job.Namespace,
+					Labels:    map[string]string{jobNameLabel: job.Name},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+			}))
 			job.Status.Succeeded = 1
 			assert.NoError(t, k8sClient.Status().Update(context.Background(), &job))
 			return
 		}
 	}()
-	assert.NoError(t, worker.Run())
+	report, err := worker.Run()
+	require.NoError(t, err)
+	assert.Equal(t, []Action{
+		{Kind: "AtlasProject", Namespace: "test", Name: "my-project", Action: ActionCreate, Method: "POST", Path: "/api/atlas/v2/groups"},
+	}, report.Actions)
 }
 
 func TestWorkerFailure(t *testing.T) {
@@ -83,6 +113,38 @@ func TestWorkerFailure(t *testing.T) {
 		WithWatchNamespaces("test").
 		WithOperatorVersion(features.LatestOperatorMajorVersion).
 		WithWaitTimeoutSec(10).
-		WithWaitForCompletion(true)
-	assert.Error(t, worker.Run())
+		WithWaitForCompletion(true).
+		WithProgressWriter(io.Discard)
+	_, err := worker.Run()
+	assert.Error(t, err)
+}
+
+func TestWorkerRunJobSpec(t *testing.T) {
+	schm := scheme.Scheme
+	assert.NoError(t, akov2.AddToScheme(schm))
+	k8sClient := fake.NewClientBuilder().WithScheme(schm).WithRuntimeObjects().Build()
+	worker := NewWorker().WithK8SClient(k8sClient).
+		WithTargetNamespace("test").
+		WithJobNamespace("atlas").
+		WithWatchNamespaces("test,apps").
+		WithOperatorVersion("2.13.0").
+		WithAtlasDomain("https://cloud.mongodbgov.com/").
+		WithImageRegistry("registry.example.com/mirror/").
+		WithServiceAccount("atlas-operator").
+		WithProgressWriter(io.Discard)
+	report, err := worker.Run()
+	require.NoError(t, err)
+	assert.Nil(t, report)
+
+	var jobs batchv1.JobList
+	require.NoError(t, k8sClient.List(context.Background(), &jobs, client.MatchingLabels{"app": "ako-dry-run"}))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, "atlas", jobs.Items[0].Namespace)
+	pod := jobs.Items[0].Spec.Template.Spec
+	assert.Equal(t, "atlas-operator", pod.ServiceAccountName)
+	require.Len(t, pod.Containers, 1)
+	assert.Equal(t, "registry.example.com/mirror/mongodb/atlas-kubernetes-operator:2.13.0", pod.Containers[0].Image)
+	assert.Contains(t, pod.Containers[0].Args, "--atlas-domain=https://cloud.mongodbgov.com/")
+	assert.Contains(t, pod.Containers[0].Env, corev1.EnvVar{Name: "WATCH_NAMESPACE", Value: "test,apps"})
+	assert.Contains(t, pod.Containers[0].Env, corev1.EnvVar{Name: "OPERATOR_NAMESPACE", Value: "atlas"})
 }
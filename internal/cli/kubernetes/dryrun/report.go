@@ -0,0 +1,160 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+
+	maxLogLineSize = 1024 * 1024
+)
+
+// dryRunEventPattern matches the requests the operator reports instead of sending them to Atlas in dry-run mode,
+// such as "Would create (POST) /api/atlas/v2/groups" or "Would execute OPTIONS /api/atlas/v2/groups".
+var dryRunEventPattern = regexp.MustCompile(`Would (create|update|delete|execute) (?:\((\w+)\)|(\w+)) (/[^\s"',;]+)`)
+
+// Report lists the changes the operator would make in Atlas, as found in the logs of a dry-run job.
+type Report struct {
+	Job     string   `json:"job"`
+	Actions []Action `json:"actions"`
+}
+
+// Action is a request the operator would send to Atlas to reconcile a custom resource.
+// The resource is empty when the log entry reporting the request does not name it.
+type Action struct {
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Action    string `json:"action"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+}
+
+// ParseLogs reads the JSON logs of an operator running in dry-run mode and returns the planned actions,
+// without duplicates and sorted by custom resource. Lines which are not JSON are ignored.
+func ParseLogs(logs io.Reader) ([]Action, error) {
+	kinds := atlasKinds()
+	found := map[Action]struct{}{}
+
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+	for scanner.Scan() {
+		entry := map[string]any{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+
+		for _, action := range entryActions(entry, kinds) {
+			found[action] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the dry-run logs: %w", err)
+	}
+
+	return slices.SortedFunc(maps.Keys(found), func(a, b Action) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Method, b.Method),
+		)
+	}), nil
+}
+
+// entryActions returns the actions reported in any field of a log entry. The operator logs the custom resource
+// being reconciled under its lowercased kind, such as "atlasproject": "namespace/name".
+func entryActions(entry map[string]any, kinds map[string]string) []Action {
+	resource := Action{}
+	for key, value := range entry {
+		kind, ok := kinds[strings.ToLower(key)]
+		if !ok {
+			continue
+		}
+		resource.Kind = kind
+		resource.Namespace, resource.Name = namespacedName(value)
+	}
+
+	var actions []Action
+	for _, value := range entry {
+		message, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		for _, match := range dryRunEventPattern.FindAllStringSubmatch(message, -1) {
+			action := resource
+			action.Action = match[1]
+			action.Method = cmp.Or(match[2], match[3])
+			action.Path = match[4]
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// namespacedName reads a resource key logged either as "namespace/name" or as an object.
+func namespacedName(value any) (string, string) {
+	switch key := value.(type) {
+	case string:
+		namespace, name, found := strings.Cut(key, "/")
+		if !found {
+			return "", key
+		}
+		return namespace, name
+	case map[string]any:
+		fields := make(map[string]string, len(key))
+		for field, fieldValue := range key {
+			fields[strings.ToLower(field)], _ = fieldValue.(string)
+		}
+		return fields["namespace"], fields["name"]
+	default:
+		return "", ""
+	}
+}
+
+// atlasKinds maps the lowercased kinds of the Atlas custom resources to their kind.
+func atlasKinds() map[string]string {
+	scheme := runtime.NewScheme()
+	_ = akov2.AddToScheme(scheme)
+
+	kinds := map[string]string{}
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.Group != akov2.GroupVersion.Group || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		kinds[strings.ToLower(gvk.Kind)] = gvk.Kind
+	}
+
+	return kinds
+}
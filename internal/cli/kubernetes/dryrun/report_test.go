@@ -0,0 +1,53 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package dryrun
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogs(t *testing.T) {
+	logs := strings.Join([]string{
+		`{"level":"INFO","ts":1718000000.1,"msg":"starting manager"}`,
+		`not a JSON line`,
+		`{"level":"ERROR","msg":"resource failed","atlasdeployment":"apps/my-cluster","error":"DryRun event: Would update (PATCH) /api/atlas/v2/groups/6650/clusters/my-cluster"}`,
+		`{"level":"ERROR","msg":"resource failed","atlasdeployment":"apps/my-cluster","error":"DryRun event: Would update (PATCH) /api/atlas/v2/groups/6650/clusters/my-cluster"}`,
+		`{"level":"ERROR","msg":"resource failed","atlasproject":{"Namespace":"apps","Name":"my-project"},` +
+			`"error":"DryRun event: Would create (POST) /api/atlas/v2/groups/6650/accessList, DryRun event: Would delete (DELETE) /api/atlas/v2/groups/6650/integrations/DATADOG"}`,
+		`{"level":"INFO","msg":"DryRun event: Would execute OPTIONS /api/atlas/v2/groups"}`,
+		`{"level":"INFO","msg":"reconciled","atlasdatabaseuser":"apps/my-user"}`,
+	}, "\n")
+
+	actions, err := ParseLogs(strings.NewReader(logs))
+	require.NoError(t, err)
+	assert.Equal(t, []Action{
+		{Action: "execute", Method: "OPTIONS", Path: "/api/atlas/v2/groups"},
+		{Kind: "AtlasDeployment", Namespace: "apps", Name: "my-cluster", Action: ActionUpdate, Method: "PATCH", Path: "/api/atlas/v2/groups/6650/clusters/my-cluster"},
+		{Kind: "AtlasProject", Namespace: "apps", Name: "my-project", Action: ActionCreate, Method: "POST", Path: "/api/atlas/v2/groups/6650/accessList"},
+		{Kind: "AtlasProject", Namespace: "apps", Name: "my-project", Action: ActionDelete, Method: "DELETE", Path: "/api/atlas/v2/groups/6650/integrations/DATADOG"},
+	}, actions)
+}
+
+func TestParseLogsWithoutChanges(t *testing.T) {
+	actions, err := ParseLogs(strings.NewReader(`{"level":"INFO","msg":"reconciled","atlasproject":"apps/my-project"}`))
+	require.NoError(t, err)
+	assert.Empty(t, actions)
+}
@@ -57,6 +57,8 @@ type InstallOpts struct {
 	featureSubDeletionProtection bool
 	configOnly                   bool
 	ipAccessList                 string
+	serviceAccount               bool
+	outputManifests              bool
 }
 
 func (opts *InstallOpts) defaults() error {
@@ -124,13 +126,26 @@ func (opts *InstallOpts) ValidateIpAccessList() error {
 	return nil
 }
 
+func (opts *InstallOpts) ValidateOutputManifests() error {
+	if opts.outputManifests && opts.importResources {
+		return fmt.Errorf("%s can not be used with %s, importing resources requires a running operator", flag.OperatorOutputManifests, flag.OperatorImport)
+	}
+
+	return nil
+}
+
 func (opts *InstallOpts) Run(ctx context.Context) error {
-	kubeCtl, err := kubernetes.NewKubeCtl(opts.KubeConfig, opts.KubeContext)
-	if err != nil {
-		return err
+	// rendering the manifests does not need a cluster
+	var kubeCtl *kubernetes.KubeCtl
+	if !opts.outputManifests {
+		var err error
+		if kubeCtl, err = kubernetes.NewKubeCtl(opts.KubeConfig, opts.KubeContext); err != nil {
+			return err
+		}
 	}
 
-	installer := operator.NewInstaller(opts.versionProvider, kubeCtl, opts.featureDeletionProtection, opts.featureSubDeletionProtection)
+	installer := operator.NewInstaller(opts.versionProvider, kubeCtl, opts.featureDeletionProtection, opts.featureSubDeletionProtection).
+		WithRenderManifests(opts.outputManifests)
 
 	profile := config.Default()
 	atlasStore, err := store.New(store.AuthenticatedPreset(profile), store.WithContext(ctx))
@@ -145,7 +160,7 @@ func (opts *InstallOpts) Run(ctx context.Context) error {
 		return err
 	}
 
-	featureValidator, err := features.NewAtlasCRDs(crds.NewGithubAtlasCRDProvider(), crdVersion)
+	featureValidator, err := features.NewAtlasCRDs(crds.NewBundledAtlasCRDProvider(crds.NewGithubAtlasCRDProvider()), crdVersion)
 	if err != nil {
 		return err
 	}
@@ -159,12 +174,22 @@ func (opts *InstallOpts) Run(ctx context.Context) error {
 		WithSubResourceDeletionProtection(opts.featureSubDeletionProtection).
 		WithAtlasGov(opts.atlasGov).
 		WithConfigOnly(opts.configOnly).
+		WithServiceAccount(opts.serviceAccount).
 		Run(ctx, opts.OrgID)
 
 	if err != nil {
 		return err
 	}
 
+	if opts.outputManifests {
+		manifests, err := operator.SerializeObjects(installer.Manifests())
+		if err != nil {
+			return err
+		}
+
+		return opts.Print(manifests)
+	}
+
 	return opts.Print("Atlas Kubernetes Operator installed successfully")
 }
 
@@ -180,7 +205,10 @@ func InstallBuilder() *cobra.Command {
 		Long: `This command installs a supported version of Atlas Kubernetes Operator to an existing cluster, and optionally imports Atlas resources that are managed by the operator.
 
 This command creates an API key for the Operator and adds it to Kubernetes as a secret, which the Operator then uses to make Atlas Admin API calls.
-The key is scoped to the project when you specify the --projectName option and to the organization when you omit the --projectName option.`,
+When you specify the --serviceAccount option, this command creates a service account instead and stores its client ID and secret.
+The credentials are scoped to the project when you specify the --projectName option and to the organization when you omit the --projectName option.
+When you specify the --outputManifests option, this command prints the YAML manifests of the namespace, CRDs, RBAC objects, deployment and credentials secret instead of adding them into the cluster, so you can deploy them with tools such as Argo CD.
+The --outputManifests option does not change anything in the cluster, but it still creates the API key or service account in Atlas, as the credentials secret holds it.`,
 		Example: `# Install latest version of the operator into the default namespace:
   atlas kubernetes operator install
 
@@ -193,6 +221,9 @@ The key is scoped to the project when you specify the --projectName option and t
   # Install a specific version of the operator to a namespace and watch only this namespace and a second one:
   atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --operatorVersion=2.15.0 --targetNamespace=<namespace> --watchNamespace=<namespace>,<secondNamespace>
 
+  # Install the operator with a service account instead of an API key:
+  atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --serviceAccount
+
   # Install and import all objects from an organization:
   atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --targetNamespace=<namespace> --orgID <orgID> --import
 
@@ -200,7 +231,10 @@ The key is scoped to the project when you specify the --projectName option and t
   atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --targetNamespace=<namespace> --orgID <orgID> --projectName <project> --import
 
 	# Install the operator and disable deletion protection:
-	atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --resourceDeletionProtection=false`,
+	atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --resourceDeletionProtection=false
+
+  # Write the manifests of the operator and its credentials to a file instead of installing them:
+  atlas kubernetes operator install --ipAccessList=<IP_ADDRESS_OR_CIDR> --targetNamespace=<namespace> --outputManifests > operator.yaml`,
 		PreRunE: func(_ *cobra.Command, _ []string) error {
 			opts.versionProvider = version.NewOperatorVersion(github.NewClient(nil))
 
@@ -211,6 +245,7 @@ The key is scoped to the project when you specify the --projectName option and t
 				opts.ValidateTargetNamespace,
 				opts.ValidateWatchNamespace,
 				opts.ValidateIpAccessList,
+				opts.ValidateOutputManifests,
 			)
 		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -233,6 +268,8 @@ The key is scoped to the project when you specify the --projectName option and t
 	flags.BoolVar(&opts.featureSubDeletionProtection, flag.OperatorSubResourceDeletionProtection, true, usage.OperatorSubResourceDeletionProtection)
 	flags.BoolVar(&opts.configOnly, flag.OperatorConfigOnly, false, usage.OperatorConfigOnly)
 	flags.StringVar(&opts.ipAccessList, flag.IPAccessList, "", usage.IPAccessList)
+	flags.BoolVar(&opts.serviceAccount, flag.OperatorServiceAccount, false, usage.OperatorServiceAccount)
+	flags.BoolVar(&opts.outputManifests, flag.OperatorOutputManifests, false, usage.OperatorOutputManifests)
 
 	return cmd
 }
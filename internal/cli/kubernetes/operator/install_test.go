@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInstallOptsValidateIpAccessList(t *testing.T) {
@@ -60,3 +61,11 @@ func TestInstallOptsValidateIpAccessList(t *testing.T) {
 		})
 	}
 }
+
+func TestInstallOptsValidateOutputManifests(t *testing.T) {
+	opts := &InstallOpts{outputManifests: true}
+	require.NoError(t, opts.ValidateOutputManifests())
+
+	opts.importResources = true
+	require.EqualError(t, opts.ValidateOutputManifests(), "outputManifests can not be used with import, importing resources requires a running operator")
+}
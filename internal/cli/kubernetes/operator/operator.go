@@ -26,7 +26,12 @@ func Builder() *cobra.Command {
 		Long:  `This command manages the Atlas Kubernetes Operator.`,
 	}
 
-	cmd.AddCommand(InstallBuilder())
+	cmd.AddCommand(
+		InstallBuilder(),
+		UpgradeBuilder(),
+		StatusBuilder(),
+		UninstallBuilder(),
+	)
 
 	return cmd
 }
@@ -0,0 +1,116 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli/require"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const statusTemplate = `NAMESPACE	VERSION	WATCHED NAMESPACES	DELETION PROTECTION	SUB-RESOURCE DELETION PROTECTION
+{{.Namespace}}	{{.Version}}	{{if .WatchNamespaces}}{{range $i, $ns := .WatchNamespaces}}{{if $i}},{{end}}{{$ns}}{{end}}{{else}}all{{end}}	{{.ResourceDeletionProtectionEnabled}}	{{.SubResourceDeletionProtectionEnabled}}
+
+POD	PHASE	READY	RESTARTS{{range .Pods}}
+{{.Name}}	{{.Phase}}	{{.Ready}}	{{.Restarts}}{{end}}
+
+CRD	STATUS{{range .CRDs}}
+{{.Name}}	{{.Status}}{{end}}
+
+KIND	NAMESPACE	NAME	READY	REASON{{range .Resources}}
+{{.Kind}}	{{.Namespace}}	{{.Name}}	{{.Ready}}	{{.Reason}}{{end}}
+`
+
+type StatusOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+
+	targetNamespace string
+	KubeConfig      string
+	KubeContext     string
+}
+
+func (opts *StatusOpts) ValidateTargetNamespace() error {
+	if opts.targetNamespace == "" {
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Label(opts.targetNamespace); len(errs) != 0 {
+		return fmt.Errorf("%s parameter is invalid: %v", flag.OperatorTargetNamespace, errs)
+	}
+
+	return nil
+}
+
+func (opts *StatusOpts) Run(ctx context.Context) error {
+	kubeCtl, err := kubernetes.NewKubeCtl(opts.KubeConfig, opts.KubeContext)
+	if err != nil {
+		return err
+	}
+
+	status, err := operator.NewStatusReader(kubeCtl, crds.NewBundledAtlasCRDProvider(crds.NewGithubAtlasCRDProvider())).
+		Read(ctx, opts.targetNamespace)
+	if err != nil {
+		return err
+	}
+
+	return opts.Print(status)
+}
+
+func StatusBuilder() *cobra.Command {
+	const use = "status"
+	opts := &StatusOpts{}
+	opts.Template = statusTemplate
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Args:    require.NoArgs,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Show the status of Atlas Kubernetes Operator and of the Atlas custom resources in a cluster.",
+		Long: `This command shows the version, the watched namespaces and the deletion protection settings of an Atlas Kubernetes Operator installed in a cluster, along with the readiness of its pods.
+
+It also checks whether the installed CRDs are the ones released with the operator version, and lists every Atlas custom resource with its Ready condition and the reason it is not ready.`,
+		Example: `# Show the status of the operator found in the cluster:
+  atlas kubernetes operator status
+
+  # Show the status of the operator installed in a namespace of another cluster:
+  atlas kubernetes operator status --targetNamespace=<namespace> --kubeContext=<context>`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.ValidateTargetNamespace,
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&opts.targetNamespace, flag.OperatorTargetNamespace, "", usage.OperatorTargetNamespaceStatus)
+	flags.StringVar(&opts.KubeConfig, flag.KubernetesClusterConfig, "", usage.KubernetesClusterConfig)
+	flags.StringVar(&opts.KubeContext, flag.KubernetesClusterContext, "", usage.KubernetesClusterContext)
+
+	return cmd
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli/require"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type UninstallOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+
+	targetNamespace   string
+	KubeConfig        string
+	KubeContext       string
+	deleteCRDs        bool
+	deleteResources   bool
+	revokeCredentials bool
+	force             bool
+}
+
+func (opts *UninstallOpts) ValidateTargetNamespace() error {
+	if opts.targetNamespace == "" {
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Label(opts.targetNamespace); len(errs) != 0 {
+		return fmt.Errorf("%s parameter is invalid: %v", flag.OperatorTargetNamespace, errs)
+	}
+
+	return nil
+}
+
+func (opts *UninstallOpts) Run(ctx context.Context) error {
+	kubeCtl, err := kubernetes.NewKubeCtl(opts.KubeConfig, opts.KubeContext)
+	if err != nil {
+		return err
+	}
+
+	namespace := opts.targetNamespace
+	if namespace == "" {
+		deployment, err := kubeCtl.FindAtlasOperator(ctx)
+		if err != nil {
+			return err
+		}
+		namespace = deployment.Namespace
+	}
+
+	var atlasStore store.OperatorOrgStore
+	if opts.revokeCredentials {
+		atlasStore, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = operator.NewUninstall(kubeCtl, atlasStore, namespace).
+		WithDeleteCRDs(opts.deleteCRDs).
+		WithDeleteResources(opts.deleteResources).
+		WithRevokeCredentials(opts.revokeCredentials).
+		WithForce(opts.force).
+		Run(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	return opts.Print("Atlas Kubernetes Operator uninstalled successfully")
+}
+
+func UninstallBuilder() *cobra.Command {
+	const use = "uninstall"
+	opts := &UninstallOpts{}
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Args:    require.NoArgs,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Uninstall Atlas Kubernetes Operator from a cluster.",
+		Long: `This command removes the Atlas Kubernetes Operator deployment from a cluster, along with its RBAC objects and the secret holding its Atlas credentials.
+
+The CRDs and the custom resources are kept by default. When you delete them, the custom resources are released first, so the Atlas resources they manage are never deleted.
+Only the custom resources of the namespaces watched by the operator are deleted, unless you delete the CRDs, which deletes the custom resources of every namespace.
+The CRDs are not deleted while custom resources exist or other operators are installed in the cluster, unless you force the deletion. Custom resources alone do not block the deletion when you also delete them.
+When you specify the --revokeCredentials option, this command also deletes from Atlas the API key or service account the operator used.`,
+		Example: `# Uninstall the operator, keeping the CRDs and the custom resources:
+  atlas kubernetes operator uninstall
+
+  # Uninstall the operator from a namespace and revoke its API key or service account:
+  atlas kubernetes operator uninstall --targetNamespace=<namespace> --revokeCredentials
+
+  # Uninstall the operator and delete its CRDs and custom resources, without deleting any Atlas resource:
+  atlas kubernetes operator uninstall --deleteCRDs --deleteResources`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.ValidateTargetNamespace,
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&opts.targetNamespace, flag.OperatorTargetNamespace, "", usage.OperatorTargetNamespaceUninstall)
+	flags.StringVar(&opts.KubeConfig, flag.KubernetesClusterConfig, "", usage.KubernetesClusterConfig)
+	flags.StringVar(&opts.KubeContext, flag.KubernetesClusterContext, "", usage.KubernetesClusterContext)
+	flags.BoolVar(&opts.deleteCRDs, flag.OperatorDeleteCRDs, false, usage.OperatorDeleteCRDs)
+	flags.BoolVar(&opts.deleteResources, flag.OperatorDeleteResources, false, usage.OperatorDeleteResources)
+	flags.BoolVar(&opts.revokeCredentials, flag.OperatorRevokeCredentials, false, usage.OperatorRevokeCredentials)
+	flags.BoolVar(&opts.force, flag.Force, false, usage.OperatorUninstallForce)
+
+	return cmd
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/go-github/v61/github"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/cli/require"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/flag"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/version"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/usage"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type UpgradeOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+
+	versionProvider version.AtlasOperatorVersionProvider
+
+	operatorVersion string
+	targetNamespace string
+	KubeConfig      string
+	KubeContext     string
+	force           bool
+}
+
+func (opts *UpgradeOpts) defaults() error {
+	if opts.operatorVersion == "" {
+		latest, err := opts.versionProvider.GetLatest()
+		if err != nil {
+			return err
+		}
+
+		opts.operatorVersion = latest
+	}
+
+	return nil
+}
+
+func (opts *UpgradeOpts) ValidateTargetNamespace() error {
+	if opts.targetNamespace == "" {
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Label(opts.targetNamespace); len(errs) != 0 {
+		return fmt.Errorf("%s parameter is invalid: %v", flag.OperatorTargetNamespace, errs)
+	}
+
+	return nil
+}
+
+func (opts *UpgradeOpts) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	kubeCtl, err := kubernetes.NewKubeCtl(opts.KubeConfig, opts.KubeContext)
+	if err != nil {
+		return err
+	}
+
+	namespace := opts.targetNamespace
+	if namespace == "" {
+		deployment, err := kubeCtl.FindAtlasOperator(ctx)
+		if err != nil {
+			return err
+		}
+		namespace = deployment.Namespace
+	}
+
+	crdVersion, err := features.CRDCompatibleVersion(opts.operatorVersion)
+	if err != nil {
+		return err
+	}
+
+	featureValidator, err := features.NewAtlasCRDs(crds.NewBundledAtlasCRDProvider(crds.NewGithubAtlasCRDProvider()), crdVersion)
+	if err != nil {
+		return err
+	}
+
+	installer := operator.NewInstaller(opts.versionProvider, kubeCtl, true, true).WithUpgrade(true)
+	upgrade := operator.NewUpgrade(installer, opts.versionProvider, featureValidator, kubeCtl, opts.operatorVersion, namespace)
+
+	warnings, err := upgrade.Check(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		if _, err := fmt.Fprintf(out, "Warning: %s\n", warning); err != nil {
+			return err
+		}
+	}
+
+	if len(warnings) > 0 && !opts.force {
+		confirmed, err := cli.Confirm(in, out, fmt.Sprintf("The operator no longer acts on the fields above once upgraded to version %s. Upgrade anyway?", opts.operatorVersion))
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	installedVersion := upgrade.Installed().Version
+	if err := upgrade.Run(ctx); err != nil {
+		return err
+	}
+
+	return opts.Print(fmt.Sprintf("Atlas Kubernetes Operator upgraded from version %s to %s successfully", installedVersion, opts.operatorVersion))
+}
+
+func UpgradeBuilder() *cobra.Command {
+	const use = "upgrade"
+	opts := &UpgradeOpts{}
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Args:    require.NoArgs,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Upgrade Atlas Kubernetes Operator installed in a cluster.",
+		Long: `This command upgrades an Atlas Kubernetes Operator installed in a cluster to a newer supported version, updating its CRDs, RBAC objects and deployment in place.
+
+The watched namespaces, the deletion protection settings and the Atlas domain of the installed operator are kept.
+Before changing anything, this command lists the fields of existing custom resources that the CRDs of the new version do not have, and asks for confirmation.`,
+		Example: `# Upgrade the operator to the latest version:
+  atlas kubernetes operator upgrade
+
+  # Upgrade the operator installed in a namespace to a specific version:
+  atlas kubernetes operator upgrade --targetNamespace=<namespace> --operatorVersion=2.15.0
+
+  # Upgrade the operator without asking for confirmation:
+  atlas kubernetes operator upgrade --operatorVersion=2.15.0 --force`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			opts.versionProvider = version.NewOperatorVersion(github.NewClient(nil))
+
+			return opts.PreRunE(
+				opts.defaults,
+				opts.ValidateTargetNamespace,
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context(), cmd.InOrStdin(), cmd.ErrOrStderr())
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&opts.operatorVersion, flag.OperatorVersion, "", usage.OperatorVersionUpgrade)
+	flags.StringVar(&opts.targetNamespace, flag.OperatorTargetNamespace, "", usage.OperatorTargetNamespaceUpgrade)
+	flags.StringVar(&opts.KubeConfig, flag.KubernetesClusterConfig, "", usage.KubernetesClusterConfig)
+	flags.StringVar(&opts.KubeContext, flag.KubernetesClusterContext, "", usage.KubernetesClusterContext)
+	flags.BoolVar(&opts.force, flag.Force, false, usage.Force)
+
+	return cmd
+}
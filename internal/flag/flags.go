@@ -41,4 +41,27 @@ const (
 	IndependentResources                  = "independentResources" // IndependentResources flag
 	IPAccessList                          = "ipAccessList"         // IPAccessList flag
 	CRDType                               = "crdType"              // CRDType flag
+	OutputDir                             = "outputDir"            // OutputDir flag
+	AllProjects                           = "allProjects"          // AllProjects flag
+	ProjectNameFilter                     = "projectNameFilter"    // ProjectNameFilter flag
+	ProjectTag                            = "projectTag"           // ProjectTag flag
+	Workers                               = "workers"              // Workers flag
+	Include                               = "include"              // Include flag
+	Exclude                               = "exclude"              // Exclude flag
+	CRDsPath                              = "crdsPath"             // CRDsPath flag
+	OperatorServiceAccount                = "serviceAccount"       // OperatorServiceAccount flag
+	File                                  = "file"                 // File flag
+	FileShort                             = "f"                    // FileShort flag
+	Force                                 = "force"                // Force flag
+	OperatorDeleteCRDs                    = "deleteCRDs"           // OperatorDeleteCRDs flag
+	OperatorDeleteResources               = "deleteResources"      // OperatorDeleteResources flag
+	OperatorRevokeCredentials             = "revokeCredentials"    // OperatorRevokeCredentials flag
+	OperatorOutputManifests               = "outputManifests"      // OperatorOutputManifests flag
+	Output                                = "output"               // Output flag
+	OutputShort                           = "o"                    // OutputShort flag
+	SecretsBackend                        = "secretsBackend"       // SecretsBackend flag
+	ExternalSecretStore                   = "externalSecretStore"  // ExternalSecretStore flag
+	ExternalSecretsPath                   = "externalSecretsPath"  // ExternalSecretsPath flag
+	SealedSecretsCert                     = "sealedSecretsCert"    // SealedSecretsCert flag
+	ImageRegistry                         = "imageRegistry"        // ImageRegistry flag
 )
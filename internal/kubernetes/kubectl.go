@@ -18,57 +18,88 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// AtlasOperatorLabels are set on every resource of an operator installation.
+var AtlasOperatorLabels = map[string]string{
+	"app.kubernetes.io/component": "controller",
+	"app.kubernetes.io/instance":  "mongodb-atlas-kubernetes-operator",
+	"app.kubernetes.io/name":      "mongodb-atlas-kubernetes-operator",
+}
+
+var (
+	ErrOperatorNotFound  = errors.New("couldn't find an operator installed in any accessible namespace")
+	ErrMultipleOperators = errors.New("more than one operator is installed, set the namespace of the one to use. operators are installed in namespaces")
+)
+
 type KubeCtl struct {
-	config *api.Config
-	client client.Client
+	config     *api.Config
+	restConfig *rest.Config
+	client     client.Client
 }
 
-func (ctl *KubeCtl) FindAtlasOperator(ctx context.Context) (*appsv1.Deployment, error) {
+// FindAtlasOperators returns the operator deployments of every accessible namespace.
+// Namespaces which can not be listed are skipped with a warning.
+func (ctl *KubeCtl) FindAtlasOperators(ctx context.Context) ([]appsv1.Deployment, error) {
 	namespaces := corev1.NamespaceList{}
 	err := ctl.client.List(ctx, &namespaces, &client.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("unable to find operator installation. failed to list cluster namespaces: %w", err)
 	}
 
-	listOptions := client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(
-			map[string]string{
-				"app.kubernetes.io/component": "controller",
-				"app.kubernetes.io/instance":  "mongodb-atlas-kubernetes-operator",
-				"app.kubernetes.io/name":      "mongodb-atlas-kubernetes-operator",
-			},
-		),
-	}
-
+	var operators []appsv1.Deployment
 	for _, namespace := range namespaces.Items {
 		deployments := appsv1.DeploymentList{}
-		err := ctl.client.List(ctx, &deployments, &listOptions)
+		err := ctl.client.List(ctx, &deployments,
+			client.InNamespace(namespace.GetName()),
+			client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(AtlasOperatorLabels)},
+		)
 		if err != nil {
 			_, _ = log.Warningf("failed to look into namespace %s: %v", namespace.GetName(), err)
+			continue
 		}
 
-		if len(deployments.Items) > 0 {
-			if err != nil {
-				return nil, err
-			}
+		operators = append(operators, deployments.Items...)
+	}
 
-			return &deployments.Items[0], nil
-		}
+	return operators, nil
+}
+
+// FindAtlasOperator returns the operator deployment of the cluster. It fails when no operator
+// or more than one operator is installed, as the one to use can not be guessed.
+func (ctl *KubeCtl) FindAtlasOperator(ctx context.Context) (*appsv1.Deployment, error) {
+	operators, err := ctl.FindAtlasOperators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(operators) {
+	case 0:
+		return nil, ErrOperatorNotFound
+	case 1:
+		return &operators[0], nil
+	}
+
+	namespaces := make([]string, 0, len(operators))
+	for _, operator := range operators {
+		namespaces = append(namespaces, operator.Namespace)
 	}
 
-	return nil, errors.New("couldn't find an operator installed in any accessible namespace")
+	return nil, fmt.Errorf("%w: %s", ErrMultipleOperators, strings.Join(namespaces, ", "))
 }
 
 func (ctl *KubeCtl) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
@@ -91,6 +122,14 @@ func (ctl *KubeCtl) List(ctx context.Context, obj client.ObjectList, opts ...cli
 	return ctl.client.List(ctx, obj, opts...)
 }
 
+func (ctl *KubeCtl) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return ctl.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (ctl *KubeCtl) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	return ctl.client.Apply(ctx, obj, opts...)
+}
+
 func (ctl *KubeCtl) loadConfig(configFile string) error {
 	pathOptions := clientcmd.NewDefaultPathOptions()
 
@@ -131,16 +170,33 @@ func (ctl *KubeCtl) setupClient(witContext string) error {
 		return err
 	}
 
+	err = apiextensionsv1.AddToScheme(scheme.Scheme)
+	if err != nil {
+		return err
+	}
+
 	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
 	if err != nil {
 		return fmt.Errorf("unable to setup kubernetes client: %w", err)
 	}
 
+	ctl.restConfig = restConfig
 	ctl.client = k8sClient
 
 	return nil
 }
 
+// RESTConfig returns the configuration of the selected kubeconfig context, for the clients the controller-runtime
+// client can not replace, such as streaming pod logs. It is nil for a KubeCtl wrapping a client.
+func (ctl *KubeCtl) RESTConfig() *rest.Config {
+	return ctl.restConfig
+}
+
+// NewKubeCtlFromClient wraps an already configured client, e.g. a fake one used by tests.
+func NewKubeCtlFromClient(c client.Client) *KubeCtl {
+	return &KubeCtl{client: c}
+}
+
 func NewKubeCtl(fromKubeConfig string, withContext string) (*KubeCtl, error) {
 	ctl := &KubeCtl{}
 
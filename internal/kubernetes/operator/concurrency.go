@@ -0,0 +1,51 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import "sync"
+
+// DefaultMaxWorkers is the default number of resources fetched from Atlas concurrently.
+const DefaultMaxWorkers = 8
+
+// runConcurrently calls fn for every item using at most workers goroutines.
+// Results keep the order of the items and the error returned is the one of the first
+// failing item, so the outcome does not depend on how calls were scheduled.
+func runConcurrently[I, O any](workers int, items []I, fn func(I) (O, error)) ([]O, error) {
+	results := make([]O, len(items))
+	errs := make([]error, len(items))
+
+	sem := make(chan struct{}, max(workers, 1))
+	wg := sync.WaitGroup{}
+	for i, item := range items {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i], errs[i] = fn(item)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
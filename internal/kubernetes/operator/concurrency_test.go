@@ -0,0 +1,70 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	items := []int{5, 4, 3, 2, 1, 0}
+
+	t.Run("should keep the order of the items", func(t *testing.T) {
+		results, err := runConcurrently(3, items, func(item int) (string, error) {
+			// later items finish first
+			time.Sleep(time.Duration(item) * time.Millisecond)
+			return fmt.Sprint(item), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"5", "4", "3", "2", "1", "0"}, results)
+	})
+
+	t.Run("should not exceed the number of workers", func(t *testing.T) {
+		running, maxRunning := atomic.Int32{}, atomic.Int32{}
+		_, err := runConcurrently(2, items, func(int) (struct{}, error) {
+			current := running.Add(1)
+			for {
+				seen := maxRunning.Load()
+				if current <= seen || maxRunning.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return struct{}{}, nil
+		})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+	})
+
+	t.Run("should return the error of the first failing item", func(t *testing.T) {
+		_, err := runConcurrently(len(items), items, func(item int) (int, error) {
+			if item < 3 {
+				return 0, errors.New(fmt.Sprint("failed ", item))
+			}
+			return item, nil
+		})
+		require.EqualError(t, err, "failed 2")
+	})
+}
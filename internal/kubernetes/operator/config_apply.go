@@ -22,21 +22,46 @@ import (
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
-	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
+// FieldManager is the server-side apply field manager owning the fields set by config apply.
+const FieldManager = "atlas-cli-plugin-kubernetes"
+
+type ApplyAction string
+
+const (
+	ApplyActionCreated   ApplyAction = "created"
+	ApplyActionUpdated   ApplyAction = "updated"
+	ApplyActionUnchanged ApplyAction = "unchanged"
+)
+
+// ApplyResult reports what server-side apply did to a single object.
+type ApplyResult struct {
+	Kind      string      `json:"kind"`
+	Namespace string      `json:"namespace"`
+	Name      string      `json:"name"`
+	Action    ApplyAction `json:"action"`
+}
+
 type ConfigApply struct {
 	OrgID        string
 	ProjectID    string
 	ClusterNames []string
 
-	Namespace string
-	Version   string
+	Namespace       string
+	Version         string
+	CreateNamespace bool
 
-	kubeCtl  *kubernetes.KubeCtl
-	exporter *ConfigExporter
+	kubeCtl           *kubernetes.KubeCtl
+	exporter          *ConfigExporter
+	generatedExporter *GeneratedExporter
 }
 
 type NewConfigApplyParams struct {
@@ -45,14 +70,17 @@ type NewConfigApplyParams struct {
 
 	KubeCtl  *kubernetes.KubeCtl
 	Exporter *ConfigExporter
+	// GeneratedExporter, when set, is used instead of Exporter to apply generated CRDs
+	GeneratedExporter *GeneratedExporter
 }
 
 func NewConfigApply(params NewConfigApplyParams) *ConfigApply {
 	return &ConfigApply{
-		OrgID:     params.OrgID,
-		ProjectID: params.ProjectID,
-		kubeCtl:   params.KubeCtl,
-		exporter:  params.Exporter,
+		OrgID:             params.OrgID,
+		ProjectID:         params.ProjectID,
+		kubeCtl:           params.KubeCtl,
+		exporter:          params.Exporter,
+		generatedExporter: params.GeneratedExporter,
 	}
 }
 
@@ -68,111 +96,188 @@ func (apply *ConfigApply) WithNamespace(namespace string) *ConfigApply {
 	return apply
 }
 
-func (apply *ConfigApply) Run() error {
-	ProjectResources, projectName, err := apply.exporter.exportProject()
+// WithCreateNamespace makes the target namespace part of the applied resources,
+// so it is created when it does not exist yet.
+func (apply *ConfigApply) WithCreateNamespace(enabled bool) *ConfigApply {
+	apply.CreateNamespace = enabled
+
+	return apply
+}
+
+func (apply *ConfigApply) Run() ([]ApplyResult, error) {
+	ctx := context.Background()
+
+	objects, err := apply.exportObjects(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	DeploymentResources, err := apply.exporter.exportDeployments(projectName)
-	if err != nil {
-		return err
+	results := make([]ApplyResult, 0, len(objects))
+	for _, object := range objects {
+		result, err := apply.applyObject(ctx, object)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
 	}
 
-	streamsResources, err := apply.exporter.exportAtlasStreamProcessing(projectName)
+	return results, nil
+}
+
+// exportObjects returns the exported resources in the order they have to be applied.
+func (apply *ConfigApply) exportObjects(ctx context.Context) ([]runtime.Object, error) {
+	objects, err := apply.exportResources(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	dataFederationResources, err := apply.exporter.exportDataFederation(projectName)
-	if err != nil {
-		return err
+	if apply.CreateNamespace {
+		objects = append([]runtime.Object{NewNamespace(apply.Namespace)}, objects...)
 	}
 
-	sortedResources := sortResources(ProjectResources, DeploymentResources, streamsResources, dataFederationResources, apply.Version)
+	return objects, nil
+}
 
-	for _, objects := range sortedResources {
+func (apply *ConfigApply) exportResources(ctx context.Context) ([]runtime.Object, error) {
+	if apply.generatedExporter != nil {
+		// the generated exporter already places referenced objects before the objects referencing them
+		objects, err := apply.generatedExporter.exportObjects(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]runtime.Object, 0, len(objects))
 		for _, object := range objects {
-			if apply.exporter.patcher != nil {
-				err = apply.exporter.patcher.Patch(object)
-				if err != nil {
-					return fmt.Errorf("error patching %v: %w", object.GetObjectKind().GroupVersionKind(), err)
-				}
-			}
-
-			ctrlObj, ok := object.(client.Object)
-			if !ok {
-				return errors.New("unable to apply resource")
-			}
-
-			err = apply.kubeCtl.Create(context.Background(), ctrlObj, &client.CreateOptions{})
-			if err != nil {
-				return err
-			}
+			result = append(result, object)
 		}
+
+		return result, nil
+	}
+
+	resources, err := apply.exporter.Export()
+	if err != nil {
+		return nil, err
+	}
+
+	sortedResources, err := sortResources(resources, apply.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]runtime.Object, 0, len(resources))
+	for _, objects := range sortedResources {
+		result = append(result, objects...)
 	}
 
-	return nil
+	return result, nil
 }
 
-func sortResources(
-	projectResources, deploymentResources, streamsResources, dataFederationResources []runtime.Object,
-	version string,
-) [][]runtime.Object {
-	resources, versionFound := features.GetResourcesForVersion(version)
-	if !versionFound {
-		return nil
+// applyObject server-side applies the object owning only the fields the exporter sets,
+// so fields managed by others (e.g. the operator or kubectl) are left untouched.
+func (apply *ConfigApply) applyObject(ctx context.Context, object runtime.Object) (*ApplyResult, error) {
+	ctrlObj, ok := object.(client.Object)
+	if !ok {
+		return nil, errors.New("unable to apply resource")
 	}
 
-	sortedResources := make([][]runtime.Object, len(resources)+1)
+	desired, err := toApplyUnstructured(ctrlObj)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, resource := range projectResources {
-		if _, ok := resource.(*corev1.Secret); ok {
-			sortedResources[0] = append(sortedResources[0], resource)
-		}
+	result := &ApplyResult{
+		Kind:      desired.GetKind(),
+		Namespace: desired.GetNamespace(),
+		Name:      desired.GetName(),
+		Action:    ApplyActionCreated,
+	}
 
-		if _, ok := resource.(*akov2.AtlasTeam); ok {
-			sortedResources[1] = append(sortedResources[1], resource)
-		}
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	err = apply.kubeCtl.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	switch {
+	case err == nil:
+		result.Action = ApplyActionUpdated
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", result.Kind, result.Namespace, result.Name, err)
+	}
 
-		if _, ok := resource.(*akov2.AtlasProject); ok {
-			sortedResources[2] = append(sortedResources[2], resource)
-		}
+	err = apply.kubeCtl.Apply(ctx, client.ApplyConfigurationFromUnstructured(desired), client.FieldOwner(FieldManager))
+	if err != nil {
+		return nil, err
+	}
 
-		if _, ok := resource.(*akov2.AtlasDatabaseUser); ok {
-			sortedResources[3] = append(sortedResources[3], resource)
-		}
+	if result.Action == ApplyActionUpdated && !hasChanged(existing, desired) {
+		result.Action = ApplyActionUnchanged
 	}
 
-	for _, resource := range deploymentResources {
-		if _, ok := resource.(*akov2.AtlasBackupPolicy); ok {
-			sortedResources[4] = append(sortedResources[4], resource)
-		}
+	return result, nil
+}
 
-		if _, ok := resource.(*akov2.AtlasBackupSchedule); ok {
-			sortedResources[5] = append(sortedResources[5], resource)
-		}
+// hasChanged compares objects ignoring bookkeeping metadata which is updated on every apply.
+func hasChanged(before, after *unstructured.Unstructured) bool {
+	before, after = before.DeepCopy(), after.DeepCopy()
+	for _, u := range []*unstructured.Unstructured{before, after} {
+		u.SetManagedFields(nil)
+		u.SetResourceVersion("")
+		u.SetGeneration(0)
+	}
+
+	return !equality.Semantic.DeepEqual(before.Object, after.Object)
+}
 
-		if _, ok := resource.(*akov2.AtlasDeployment); ok {
-			sortedResources[6] = append(sortedResources[6], resource)
+func toApplyUnstructured(obj client.Object) (*unstructured.Unstructured, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		var err error
+		gvk, err = apiutil.GVKForObject(obj, scheme.Scheme)
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine kind of resource %s: %w", obj.GetName(), err)
 		}
 	}
 
-	for _, resource := range streamsResources {
-		if _, ok := resource.(*corev1.Secret); ok {
-			sortedResources[0] = append(sortedResources[0], resource)
-		}
+	u, err := toUnstructuredWithoutStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+	u.SetGroupVersionKind(gvk)
+	// fields the server owns must not be part of an apply configuration
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	u.SetManagedFields(nil)
+	u.SetResourceVersion("")
+
+	return u, nil
+}
+
+// sortResources groups resources in the order they have to be applied. Secrets and other
+// non Atlas resources come first, followed by the Atlas resources supported by the operator
+// version, ordered by their dependencies.
+func sortResources(resources []runtime.Object, version string) ([][]runtime.Object, error) {
+	orderedResources, versionFound := features.GetResourcesInDependencyOrder(version)
+	if !versionFound {
+		return nil, fmt.Errorf(features.ErrVersionNotSupportedFmt, version)
+	}
 
-		if _, ok := resource.(*akov2.AtlasStreamConnection); ok {
-			sortedResources[7] = append(sortedResources[7], resource)
+	positions := make(map[string]int, len(orderedResources))
+	for i, resource := range orderedResources {
+		positions[resource] = i + 1
+	}
+
+	sortedResources := make([][]runtime.Object, len(orderedResources)+1)
+	for _, object := range resources {
+		gvk := object.GetObjectKind().GroupVersionKind()
+		if gvk.Group != akov2.GroupVersion.Group {
+			sortedResources[0] = append(sortedResources[0], object)
+			continue
 		}
 
-		if _, ok := resource.(*akov2.AtlasStreamInstance); ok {
-			sortedResources[8] = append(sortedResources[8], resource)
+		resource, _ := features.ResourceForKind(gvk.Kind)
+		position, ok := positions[resource]
+		if !ok {
+			return nil, fmt.Errorf("resource kind %s is not supported by operator version %s", gvk.Kind, version)
 		}
+		sortedResources[position] = append(sortedResources[position], object)
 	}
 
-	sortedResources[9] = append(sortedResources[9], dataFederationResources...)
-
-	return sortedResources
+	return sortedResources, nil
 }
@@ -0,0 +1,190 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	generated "github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter/generated"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigApply_applyObject(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(testScheme))
+	require.NoError(t, akov2.AddToScheme(testScheme))
+
+	newProject := func(projectName string) *akov2.AtlasProject {
+		return &akov2.AtlasProject{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "AtlasProject",
+				APIVersion: "atlas.mongodb.com/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-project",
+				Namespace: "test",
+			},
+			Spec: akov2.AtlasProjectSpec{
+				Name: projectName,
+			},
+		}
+	}
+
+	t.Run("should report created, unchanged and updated objects", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).Build()
+		apply := NewConfigApply(NewConfigApplyParams{KubeCtl: kubernetes.NewKubeCtlFromClient(k8sClient)})
+
+		result, err := apply.applyObject(context.Background(), newProject("Project 1"))
+		require.NoError(t, err)
+		assert.Equal(t, &ApplyResult{Kind: "AtlasProject", Namespace: "test", Name: "my-project", Action: ApplyActionCreated}, result)
+
+		result, err = apply.applyObject(context.Background(), newProject("Project 1"))
+		require.NoError(t, err)
+		assert.Equal(t, ApplyActionUnchanged, result.Action)
+
+		result, err = apply.applyObject(context.Background(), newProject("Project 2"))
+		require.NoError(t, err)
+		assert.Equal(t, ApplyActionUpdated, result.Action)
+
+		project := &akov2.AtlasProject{}
+		require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "my-project", Namespace: "test"}, project))
+		assert.Equal(t, "Project 2", project.Spec.Name)
+	})
+
+	t.Run("should leave fields owned by other managers untouched", func(t *testing.T) {
+		existing := newProject("Project 1")
+		existing.Labels = map[string]string{"team": "platform"}
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).Build()
+		require.NoError(t, k8sClient.Create(context.Background(), existing, client.FieldOwner("kubectl")))
+		apply := NewConfigApply(NewConfigApplyParams{KubeCtl: kubernetes.NewKubeCtlFromClient(k8sClient)})
+
+		result, err := apply.applyObject(context.Background(), newProject("Project 1"))
+		require.NoError(t, err)
+		assert.Equal(t, ApplyActionUnchanged, result.Action)
+
+		project := &akov2.AtlasProject{}
+		require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "my-project", Namespace: "test"}, project))
+		assert.Equal(t, map[string]string{"team": "platform"}, project.Labels)
+	})
+}
+
+func TestSortResources(t *testing.T) {
+	t.Run("should bucket every resource kind supported by the version", func(t *testing.T) {
+		resources, ok := features.GetResourcesForVersion(features.LatestOperatorMajorVersion)
+		require.True(t, ok)
+
+		objects := []runtime.Object{}
+		for _, resource := range resources {
+			kind, ok := features.KindForResource(resource)
+			require.True(t, ok)
+			object := &unstructured.Unstructured{}
+			object.SetGroupVersionKind(akov2.GroupVersion.WithKind(kind))
+			objects = append(objects, object)
+		}
+		objects = append(objects, &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}})
+
+		sorted, err := sortResources(objects, features.LatestOperatorMajorVersion)
+		require.NoError(t, err)
+
+		got := []string{}
+		for _, bucket := range sorted {
+			for _, object := range bucket {
+				got = append(got, object.GetObjectKind().GroupVersionKind().Kind)
+			}
+		}
+		require.Len(t, got, len(objects))
+		assert.Equal(t, "Secret", got[0])
+		assert.Less(t, slices.Index(got, "AtlasTeam"), slices.Index(got, "AtlasProject"))
+		assert.Less(t, slices.Index(got, "AtlasProject"), slices.Index(got, "AtlasDeployment"))
+		assert.Less(t, slices.Index(got, "AtlasBackupSchedule"), slices.Index(got, "AtlasDeployment"))
+		assert.Less(t, slices.Index(got, "AtlasNetworkContainer"), slices.Index(got, "AtlasNetworkPeering"))
+		assert.Less(t, slices.Index(got, "AtlasStreamConnection"), slices.Index(got, "AtlasStreamInstance"))
+	})
+
+	t.Run("should fail on an unknown atlas kind", func(t *testing.T) {
+		object := &unstructured.Unstructured{}
+		object.SetGroupVersionKind(akov2.GroupVersion.WithKind("AtlasUnknown"))
+
+		_, err := sortResources([]runtime.Object{object}, features.LatestOperatorMajorVersion)
+		require.ErrorContains(t, err, "resource kind AtlasUnknown is not supported")
+	})
+}
+
+func TestConfigApply_runGenerated(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	group := newGroup("my-project", "org-id")
+	flexCluster := newFlexCluster("my-cluster", "group-id", "AWS", "US_EAST_1")
+
+	apply := NewConfigApply(NewConfigApplyParams{
+		KubeCtl: kubernetes.NewKubeCtlFromClient(k8sClient),
+		GeneratedExporter: NewGeneratedExporter(GeneratedExporterConfig{
+			TargetNamespace: "test",
+			Scheme:          newTestScheme(t),
+			Exporters: []generated.Exporter{
+				&mockExporter{objects: []client.Object{group}},
+				&mockExporter{objects: []client.Object{flexCluster}},
+			},
+			IncludeSecrets:      true,
+			CredentialsProvider: &mockCredentialsProvider{publicKey: "public", privateKey: "private"},
+			OrgID:               "org-id",
+		}),
+	})
+
+	results, err := apply.Run()
+	require.NoError(t, err)
+	assert.Equal(t, []ApplyResult{
+		{Kind: "Secret", Namespace: "test", Name: credentialsSecretName, Action: ApplyActionCreated},
+		{Kind: "Group", Namespace: "test", Name: "group-my-project", Action: ApplyActionCreated},
+		{Kind: "FlexCluster", Namespace: "test", Name: "flexcluster-my-cluster", Action: ApplyActionCreated},
+	}, results)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: credentialsSecretName, Namespace: "test"}, secret))
+	assert.Equal(t, []byte("public"), secret.Data["publicApiKey"])
+}
+
+func TestConfigApply_createNamespace(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+
+	apply := NewConfigApply(NewConfigApplyParams{
+		KubeCtl: kubernetes.NewKubeCtlFromClient(k8sClient),
+		GeneratedExporter: NewGeneratedExporter(GeneratedExporterConfig{
+			TargetNamespace: "my-project",
+			Scheme:          newTestScheme(t),
+			Exporters:       []generated.Exporter{&mockExporter{objects: []client.Object{newGroup("my-project", "org-id")}}},
+		}),
+	}).WithNamespace("my-project").WithCreateNamespace(true)
+
+	results, err := apply.Run()
+	require.NoError(t, err)
+	assert.Equal(t, []ApplyResult{
+		{Kind: "Namespace", Name: "my-project", Action: ApplyActionCreated},
+		{Kind: "Group", Namespace: "my-project", Name: "group-my-project", Action: ApplyActionCreated},
+	}, results)
+}
@@ -0,0 +1,273 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/pmezard/go-difflib/difflib"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type DiffStatus string
+
+const (
+	DiffStatusMissing   DiffStatus = "missing"
+	DiffStatusModified  DiffStatus = "modified"
+	DiffStatusUnchanged DiffStatus = "unchanged"
+)
+
+// DiffResult holds the difference between a live Kubernetes resource and the current Atlas state.
+type DiffResult struct {
+	Kind      string     `json:"kind"`
+	Namespace string     `json:"namespace"`
+	Name      string     `json:"name"`
+	Status    DiffStatus `json:"status"`
+	Diff      string     `json:"diff,omitempty"`
+}
+
+const (
+	redactedValue        = "<redacted>"
+	redactedChangedValue = "<redacted, changed>"
+)
+
+// ignoredMetadataFields are maintained by the API server and never reflect drift.
+var ignoredMetadataFields = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"generation",
+	"creationTimestamp",
+	"selfLink",
+}
+
+// Diff compares the resources exported from Atlas with the ones found in the cluster,
+// without applying anything.
+func (apply *ConfigApply) Diff() ([]DiffResult, error) {
+	ctx := context.Background()
+
+	objects, err := apply.exportObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]DiffResult, 0, len(objects))
+	for _, object := range objects {
+		ctrlObj, ok := object.(client.Object)
+		if !ok {
+			return nil, errors.New("unable to compare resource")
+		}
+
+		desired, err := toApplyUnstructured(ctrlObj)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := apply.diffObject(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+func (apply *ConfigApply) diffObject(ctx context.Context, desired *unstructured.Unstructured) (*DiffResult, error) {
+	result := &DiffResult{
+		Kind:      desired.GetKind(),
+		Namespace: desired.GetNamespace(),
+		Name:      desired.GetName(),
+		Status:    DiffStatusModified,
+	}
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(desired.GroupVersionKind())
+	err := apply.kubeCtl.Get(ctx, client.ObjectKeyFromObject(desired), live)
+	switch {
+	case apierrors.IsNotFound(err):
+		result.Status = DiffStatusMissing
+		live = nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", result.Kind, result.Namespace, result.Name, err)
+	default:
+		live.Object, _ = ownedFields(live.Object, desired.Object).(map[string]any)
+	}
+
+	desired = desired.DeepCopy()
+	if desired.GetKind() == "Secret" {
+		if err = redactSecretData(live, desired); err != nil {
+			return nil, err
+		}
+	}
+
+	diff, err := unifiedDiff(live, desired, fmt.Sprintf("%s/%s/%s", result.Kind, result.Namespace, result.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if diff == "" {
+		result.Status = DiffStatusUnchanged
+	}
+	result.Diff = diff
+
+	return result, nil
+}
+
+// unifiedDiff renders both objects as YAML, after dropping fields that are not relevant for drift,
+// and returns the line based unified diff between them. A nil live object diffs against an empty document.
+func unifiedDiff(live, desired *unstructured.Unstructured, name string) (string, error) {
+	liveYAML, err := normalizedYAML(live)
+	if err != nil {
+		return "", err
+	}
+
+	desiredYAML, err := normalizedYAML(desired)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(desiredYAML),
+		FromFile: "kubernetes/" + name,
+		ToFile:   "atlas/" + name,
+		Context:  3,
+	})
+}
+
+func normalizedYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, field := range ignoredMetadataFields {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+
+	labels := obj.GetLabels()
+	delete(labels, features.ResourceVersion)
+	if len(labels) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "labels")
+	} else {
+		obj.SetLabels(labels)
+	}
+
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to render %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	return string(data), nil
+}
+
+// ownedFields projects a live value onto the fields set in the desired one. Fields the command does not set,
+// such as finalizers, annotations, API server defaults or fields of other managers, are dropped from the live
+// value, so they are not reported as drift. Lists are projected item by item when their lengths match.
+func ownedFields(live, desired any) any {
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		liveMap, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+		result := make(map[string]any, len(desiredValue))
+		for key, value := range desiredValue {
+			if liveValue, found := liveMap[key]; found {
+				result[key] = ownedFields(liveValue, value)
+			}
+		}
+		return result
+	case []any:
+		liveList, ok := live.([]any)
+		if !ok || len(liveList) != len(desiredValue) {
+			return live
+		}
+		result := make([]any, len(liveList))
+		for i := range liveList {
+			result[i] = ownedFields(liveList[i], desiredValue[i])
+		}
+		return result
+	default:
+		return live
+	}
+}
+
+// redactSecretData replaces the values of both Secrets with placeholders, so the diff only tells which keys
+// were added, removed or changed and never prints credentials.
+func redactSecretData(live, desired *unstructured.Unstructured) error {
+	desiredValues, err := secretValues(desired)
+	if err != nil {
+		return err
+	}
+
+	redacted := make(map[string]any, len(desiredValues))
+	for key := range desiredValues {
+		redacted[key] = redactedValue
+	}
+	unstructured.RemoveNestedField(desired.Object, "stringData")
+	desired.Object["data"] = redacted
+
+	if live == nil {
+		return nil
+	}
+
+	liveValues, err := secretValues(live)
+	if err != nil {
+		return err
+	}
+
+	liveRedacted := make(map[string]any, len(liveValues))
+	for key, value := range liveValues {
+		liveRedacted[key] = redactedValue
+		if desiredValue, found := desiredValues[key]; found && desiredValue != value {
+			redacted[key] = redactedChangedValue
+		}
+	}
+	unstructured.RemoveNestedField(live.Object, "stringData")
+	live.Object["data"] = liveRedacted
+
+	return nil
+}
+
+// secretValues returns the decoded data of a Secret merged with its string data.
+func secretValues(secret *unstructured.Unstructured) (map[string]string, error) {
+	values := map[string]string{}
+	data, _, _ := unstructured.NestedMap(secret.Object, "data")
+	for key, value := range data {
+		encoded, _ := value.(string)
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %s of secret %s: %w", key, secret.GetName(), err)
+		}
+		values[key] = string(decoded)
+	}
+
+	stringData, _, _ := unstructured.NestedMap(secret.Object, "stringData")
+	for key, value := range stringData {
+		values[key], _ = value.(string)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigApply_diffObject(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(testScheme))
+	require.NoError(t, akov2.AddToScheme(testScheme))
+
+	newProject := func(projectName, resourceVersion string) *akov2.AtlasProject {
+		return &akov2.AtlasProject{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "AtlasProject",
+				APIVersion: "atlas.mongodb.com/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-project",
+				Namespace: "test",
+				Labels: map[string]string{
+					features.ResourceVersion: resourceVersion,
+				},
+			},
+			Spec: akov2.AtlasProjectSpec{
+				Name: projectName,
+			},
+		}
+	}
+
+	diff := func(t *testing.T, k8sClient client.Client, desired client.Object) *DiffResult {
+		t.Helper()
+
+		apply := NewConfigApply(NewConfigApplyParams{KubeCtl: kubernetes.NewKubeCtlFromClient(k8sClient)})
+		obj, err := toApplyUnstructured(desired)
+		require.NoError(t, err)
+		result, err := apply.diffObject(context.Background(), obj)
+		require.NoError(t, err)
+
+		return result
+	}
+
+	t.Run("should report a missing resource", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+		result := diff(t, k8sClient, newProject("Project 1", "2.8.0"))
+		assert.Equal(t, DiffStatusMissing, result.Status)
+		assert.Contains(t, result.Diff, "+++ atlas/AtlasProject/test/my-project")
+		assert.Contains(t, result.Diff, "+  name: Project 1")
+	})
+
+	t.Run("should ignore status, server managed fields and the resource version label", func(t *testing.T) {
+		existing := newProject("Project 1", "2.7.0")
+		existing.Status.ID = "project-id"
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(existing).Build()
+
+		result := diff(t, k8sClient, newProject("Project 1", "2.8.0"))
+		assert.Equal(t, DiffStatusUnchanged, result.Status)
+		assert.Empty(t, result.Diff)
+	})
+
+	t.Run("should report the changed fields of a modified resource", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(newProject("Project 1", "2.8.0")).Build()
+
+		result := diff(t, k8sClient, newProject("Project 2", "2.8.0"))
+		assert.Equal(t, DiffStatusModified, result.Status)
+		assert.Contains(t, result.Diff, "-  name: Project 1")
+		assert.Contains(t, result.Diff, "+  name: Project 2")
+	})
+
+	t.Run("should ignore finalizers, annotations and fields set by other managers", func(t *testing.T) {
+		existing := newProject("Project 1", "2.8.0")
+		existing.Finalizers = []string{"mongodbatlas/finalizer"}
+		existing.Annotations = map[string]string{"mongodb.com/last-applied-configuration": "{}"}
+		existing.Labels["app.kubernetes.io/managed-by"] = "argocd"
+		existing.Spec.RegionUsageRestrictions = "NONE"
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(existing).Build()
+
+		result := diff(t, k8sClient, newProject("Project 1", "2.8.0"))
+		assert.Equal(t, DiffStatusUnchanged, result.Status)
+		assert.Empty(t, result.Diff)
+	})
+
+	t.Run("should report changed secret keys without their values", func(t *testing.T) {
+		newSecret := func(data map[string][]byte) *corev1.Secret {
+			return &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "my-project-credentials", Namespace: "test"},
+				Data:       data,
+			}
+		}
+		existing := newSecret(map[string][]byte{"publicApiKey": []byte("public"), "privateApiKey": []byte("old-private")})
+		k8sClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(existing).Build()
+
+		result := diff(t, k8sClient, newSecret(map[string][]byte{"publicApiKey": []byte("public"), "privateApiKey": []byte("new-private")}))
+		assert.Equal(t, DiffStatusModified, result.Status)
+		assert.Contains(t, result.Diff, "-  privateApiKey: <redacted>")
+		assert.Contains(t, result.Diff, "+  privateApiKey: <redacted, changed>")
+		for _, value := range []string{"public", "old-private", "new-private"} {
+			assert.NotContains(t, result.Diff, ": "+value)
+			assert.NotContains(t, result.Diff, base64.StdEncoding.EncodeToString([]byte(value)))
+		}
+
+		unchanged := diff(t, k8sClient, existing.DeepCopy())
+		assert.Equal(t, DiffStatusUnchanged, unchanged.Status)
+	})
+}
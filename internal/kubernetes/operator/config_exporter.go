@@ -15,7 +15,6 @@
 package operator
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
@@ -28,12 +27,14 @@ import (
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/orgsettings"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/project"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/streamsprocessing"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/serializer/json"
-	"k8s.io/client-go/kubernetes/scheme"
 )
 
 const (
@@ -59,6 +60,8 @@ type ConfigExporter struct {
 	dataFederationNames     []string
 	patcher                 Patcher
 	independentResources    bool
+	maxWorkers              int
+	resourceSelection       *features.ResourceSelection
 }
 
 type Patcher interface {
@@ -81,6 +84,7 @@ func NewConfigExporter(dataProvider store.OperatorGenericStore, credsProvider st
 		includeSecretsData:      false,
 		orgID:                   orgID,
 		dictionaryForAtlasNames: resources.AtlasNameToKubernetesName(),
+		maxWorkers:              DefaultMaxWorkers,
 	}
 }
 
@@ -124,70 +128,117 @@ func (e *ConfigExporter) WithIndependentResources(enabled bool) *ConfigExporter
 	return e
 }
 
+// WithMaxWorkers limits how many deployments, data federations and stream instances are fetched from Atlas concurrently.
+func (e *ConfigExporter) WithMaxWorkers(workers int) *ConfigExporter {
+	e.maxWorkers = workers
+	return e
+}
+
+// WithResourceSelection limits the export to the selected resources. Resources required by
+// the selected ones, such as the project, are still read from Atlas.
+func (e *ConfigExporter) WithResourceSelection(selection *features.ResourceSelection) *ConfigExporter {
+	e.resourceSelection = selection
+	return e
+}
+
+// isExported reports whether a resource is selected and supported by the target operator version.
+func (e *ConfigExporter) isExported(resourceName string) bool {
+	return e.resourceSelection.IsSelected(resourceName) && e.featureValidator.IsResourceSupported(resourceName)
+}
+
 func (e *ConfigExporter) Run() (string, error) {
 	// TODO: Add REST to OPERATOR entities matcher
-	output := bytes.NewBufferString(yamlSeparator)
-	var r []runtime.Object
+	r, err := e.Export()
+	if err != nil {
+		return "", err
+	}
+
+	return SerializeObjects(r)
+}
+
+// Export returns every exported resource, patched for the target operator version.
+func (e *ConfigExporter) Export() ([]runtime.Object, error) {
+	r, err := e.exportResources()
+	if err != nil {
+		return nil, err
+	}
+	sortObjects(r)
+	e.applySecretsPolicy(r)
+
+	if e.patcher == nil {
+		return r, nil
+	}
+
+	for _, res := range r {
+		err = e.patcher.Patch(res)
+		if err != nil {
+			return nil, fmt.Errorf("error patching %v: %w", res.GetObjectKind().GroupVersionKind(), err)
+		}
+	}
+
+	return r, nil
+}
+
+// applySecretsPolicy empties the values of every exported Secret unless secrets are included, so a builder
+// filling in credentials can not leak them. Keys are kept, to show which values have to be set.
+func (e *ConfigExporter) applySecretsPolicy(objects []runtime.Object) {
+	if e.includeSecretsData {
+		return
+	}
+
+	for _, obj := range objects {
+		if secret, ok := obj.(*corev1.Secret); ok {
+			secrets.Redact(secret)
+		}
+	}
+}
 
-	serializer := json.NewSerializerWithOptions(
-		json.DefaultMetaFactory,
-		scheme.Scheme,
-		scheme.Scheme,
-		json.SerializerOptions{Yaml: true, Pretty: true},
-	)
+// exportResources fetches the Atlas state and builds every supported resource.
+func (e *ConfigExporter) exportResources() ([]runtime.Object, error) {
+	var r []runtime.Object
 
 	projectResources, projectName, err := e.exportProject()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	r = append(r, projectResources...)
 
 	deploymentsResources, err := e.exportDeployments(projectName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	r = append(r, deploymentsResources...)
 
 	dataFederationResource, err := e.exportDataFederation(projectName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	r = append(r, dataFederationResource...)
 
 	federatedAuthResource, err := e.exportAtlasFederatedAuth(projectName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	r = append(r, federatedAuthResource...)
 
 	streamProcessingResources, err := e.exportAtlasStreamProcessing(projectName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	r = append(r, streamProcessingResources...)
 
 	orgSettingsResources, err := e.exportAtlasOrgSettings(e.orgID)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	r = append(r, orgSettingsResources...)
-
-	for _, res := range r {
-		if e.patcher != nil {
-			err = e.patcher.Patch(res)
-			if err != nil {
-				return "", fmt.Errorf("error patching %v: %w", res.GetObjectKind().GroupVersionKind(), err)
-			}
-		}
-
-		err = serializer.Encode(res, output)
-		if err != nil {
-			return "", err
+	for _, res := range orgSettingsResources {
+		// org settings are not exported when Atlas returns none
+		if !reflect.ValueOf(res).IsNil() {
+			r = append(r, res)
 		}
-		output.WriteString(yamlSeparator)
 	}
 
-	return output.String(), nil
+	return r, nil
 }
 
 //nolint:gocyclo
@@ -220,14 +271,18 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 	}
 
 	var r []runtime.Object //nolint:prealloc
-	r = append(r, projectData.Project)
-	for _, secret := range projectData.Secrets {
-		r = append(r, secret)
+	if e.resourceSelection.IsSelected(features.ResourceAtlasProject) {
+		r = append(r, projectData.Project)
+		for _, secret := range projectData.Secrets {
+			r = append(r, secret)
+		}
 	}
 
 	// Teams
-	for _, t := range projectData.Teams {
-		r = append(r, t)
+	if e.resourceSelection.IsSelected(features.ResourceAtlasTeam) {
+		for _, t := range projectData.Teams {
+			r = append(r, t)
+		}
 	}
 
 	credentialsName := credentialsName(projectData.Project.Name)
@@ -241,7 +296,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 		e.dictionaryForAtlasNames,
 	))
 
-	if e.featureValidator.IsResourceSupported(features.ResourceAtlasPrivateEndpoint) {
+	if e.isExported(features.ResourceAtlasPrivateEndpoint) {
 		privateEndpoints, err := project.BuildPrivateEndpointCustomResources(
 			e.dataProvider,
 			project.PrivateEndpointRequest{
@@ -264,7 +319,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 	}
 
 	// Independent custom roles (AtlasCustomRole CR)
-	if e.featureValidator.IsResourceSupported(features.ResourceAtlasCustomRole) {
+	if e.isExported(features.ResourceAtlasCustomRole) {
 		roles, err := project.BuildCustomRoles(e.dataProvider, project.CustomRolesRequest{
 			ProjectID:       e.projectID,
 			ProjectName:     projectData.Project.Name,
@@ -283,7 +338,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 		}
 	}
 
-	if e.featureValidator.IsResourceSupported(features.ResourceAtlasIPAccessList) {
+	if e.isExported(features.ResourceAtlasIPAccessList) {
 		ipAccessList, isEmpty, err := project.BuildIPAccessList(
 			e.dataProvider,
 			project.IPAccessListRequest{
@@ -305,7 +360,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 		}
 	}
 
-	if e.featureValidator.IsResourceSupported(features.ResourceAtlasNetworkContainer) {
+	if e.isExported(features.ResourceAtlasNetworkContainer) {
 		networkContainers, err := project.BuildNetworkContainers(
 			e.dataProvider,
 			project.NetworkContainersRequest{
@@ -327,7 +382,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 		}
 	}
 
-	if e.featureValidator.IsResourceSupported(features.ResourceAtlasNetworkPeering) {
+	if e.isExported(features.ResourceAtlasNetworkPeering) {
 		networkPeerings, err := project.BuildNetworkPeerings(
 			e.dataProvider,
 			project.NetworkPeeringsRequest{
@@ -349,7 +404,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 		}
 	}
 
-	if e.featureValidator.IsResourceSupported(features.ResourceAtlasThirdPartyIntegration) {
+	if e.isExported(features.ResourceAtlasThirdPartyIntegration) {
 		integrations, err := project.BuildThirdPartyIntegrations(
 			e.dataProvider,
 			project.ThirdPartyIntegrationRequest{
@@ -359,6 +414,7 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 				Version:             e.operatorVersion,
 				Credentials:         credentialsName,
 				IndependentResource: e.independentResources,
+				IncludeSecrets:      e.includeSecretsData,
 				Dictionary:          e.dictionaryForAtlasNames,
 			},
 		)
@@ -370,27 +426,29 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 	}
 
 	// DB users
-	usersData, relatedSecrets, err := dbusers.BuildDBUsers(
-		e.dataProvider,
-		e.projectID,
-		projectData.Project.Name,
-		e.targetNamespace,
-		credentialsName,
-		e.dictionaryForAtlasNames,
-		e.operatorVersion,
-		e.independentResources)
-	if err != nil {
-		return nil, "", err
-	}
-	for _, user := range usersData {
-		r = append(r, user)
-	}
-	for _, s := range relatedSecrets {
-		r = append(r, s)
+	if e.resourceSelection.IsSelected(features.ResourceAtlasDatabaseUser) {
+		usersData, relatedSecrets, err := dbusers.BuildDBUsers(
+			e.dataProvider,
+			e.projectID,
+			projectData.Project.Name,
+			e.targetNamespace,
+			credentialsName,
+			e.dictionaryForAtlasNames,
+			e.operatorVersion,
+			e.independentResources)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, user := range usersData {
+			r = append(r, user)
+		}
+		for _, s := range relatedSecrets {
+			r = append(r, s)
+		}
 	}
 
 	// Backup Compliance Policy
-	if projectData.BCP != nil {
+	if projectData.BCP != nil && e.resourceSelection.IsSelected(features.ResourceAtlasBackupCompliancePolicy) {
 		r = append(r, projectData.BCP)
 	}
 
@@ -400,6 +458,13 @@ func (e *ConfigExporter) exportProject() ([]runtime.Object, string, error) {
 func (e *ConfigExporter) exportDeployments(projectName string) ([]runtime.Object, error) {
 	var result []runtime.Object
 
+	// backup schedules and policies are read along with their deployment
+	if !e.resourceSelection.IsSelected(features.ResourceAtlasDeployment) &&
+		!e.resourceSelection.IsSelected(features.ResourceAtlasBackupSchedule) &&
+		!e.resourceSelection.IsSelected(features.ResourceAtlasBackupPolicy) {
+		return nil, nil
+	}
+
 	if len(e.clusterNames) == 0 {
 		clusters, err := fetchClusterNames(e.dataProvider, e.projectID)
 		if err != nil {
@@ -409,46 +474,61 @@ func (e *ConfigExporter) exportDeployments(projectName string) ([]runtime.Object
 	}
 
 	credentials := credentialsName(projectName)
-	for _, deploymentName := range e.clusterNames {
-		// Try advanced cluster first
-		if advancedCluster, err := deployment.BuildAtlasAdvancedDeployment(e.dataProvider, e.featureValidator, e.projectID, projectName, deploymentName, e.targetNamespace, credentials, e.dictionaryForAtlasNames, e.operatorVersion, e.independentResources); err == nil {
-			if advancedCluster != nil {
-				// Append deployment to result
+	deployments, err := runConcurrently(e.maxWorkers, e.clusterNames, func(deploymentName string) ([]runtime.Object, error) {
+		return e.exportDeployment(projectName, deploymentName, credentials)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, objects := range deployments {
+		result = append(result, objects...)
+	}
+
+	return result, nil
+}
+
+func (e *ConfigExporter) exportDeployment(projectName, deploymentName, credentials string) ([]runtime.Object, error) {
+	var result []runtime.Object
+
+	// Try advanced cluster first
+	if advancedCluster, err := deployment.BuildAtlasAdvancedDeployment(e.dataProvider, e.featureValidator, e.projectID, projectName, deploymentName, e.targetNamespace, credentials, e.dictionaryForAtlasNames, e.operatorVersion, e.independentResources); err == nil {
+		if advancedCluster != nil {
+			// Append deployment to result
+			if e.resourceSelection.IsSelected(features.ResourceAtlasDeployment) {
 				result = append(result, advancedCluster.Deployment)
-				// Append backup schedule
-				if advancedCluster.BackupSchedule != nil {
-					result = append(result, advancedCluster.BackupSchedule)
-				}
-				// Append backup policies (one)
-				for _, policy := range advancedCluster.BackupPolicies {
-					if policy != nil {
-						result = append(result, policy)
-					}
+			}
+			// Append backup schedule
+			if advancedCluster.BackupSchedule != nil && e.resourceSelection.IsSelected(features.ResourceAtlasBackupSchedule) {
+				result = append(result, advancedCluster.BackupSchedule)
+			}
+			// Append backup policies (one)
+			for _, policy := range advancedCluster.BackupPolicies {
+				if policy != nil && e.resourceSelection.IsSelected(features.ResourceAtlasBackupPolicy) {
+					result = append(result, policy)
 				}
 			}
-			continue
 		}
+		return result, nil
+	}
 
-		// Try flex  cluster next
-		if flexCluster, err := deployment.BuildFlexDeployments(e.dataProvider, e.projectID, projectName, deploymentName, e.targetNamespace, credentials, e.dictionaryForAtlasNames, e.operatorVersion, e.independentResources); err == nil {
-			if flexCluster != nil {
-				result = append(result, flexCluster)
-			}
-			continue
+	// Try flex  cluster next
+	if flexCluster, err := deployment.BuildFlexDeployments(e.dataProvider, e.projectID, projectName, deploymentName, e.targetNamespace, credentials, e.dictionaryForAtlasNames, e.operatorVersion, e.independentResources); err == nil {
+		if flexCluster != nil && e.resourceSelection.IsSelected(features.ResourceAtlasDeployment) {
+			result = append(result, flexCluster)
 		}
+		return result, nil
+	}
 
-		// Try serverless cluster last
-		serverlessCluster, err := deployment.BuildServerlessDeployments(e.dataProvider, e.projectID, projectName, deploymentName, e.targetNamespace, credentials, e.dictionaryForAtlasNames, e.operatorVersion, e.independentResources)
-		if err == nil {
-			if serverlessCluster != nil {
-				result = append(result, serverlessCluster)
-			}
-			continue
+	// Try serverless cluster last
+	serverlessCluster, err := deployment.BuildServerlessDeployments(e.dataProvider, e.projectID, projectName, deploymentName, e.targetNamespace, credentials, e.dictionaryForAtlasNames, e.operatorVersion, e.independentResources)
+	if err == nil {
+		if serverlessCluster != nil && e.resourceSelection.IsSelected(features.ResourceAtlasDeployment) {
+			result = append(result, serverlessCluster)
 		}
-		return nil, fmt.Errorf("%w: %s(%s), e: %w", ErrServerless, deploymentName, e.projectID, err)
+		return result, nil
 	}
-
-	return result, nil
+	return nil, fmt.Errorf("%w: %s(%s), e: %w", ErrServerless, deploymentName, e.projectID, err)
 }
 
 func fetchClusterNames(clustersProvider store.AllClustersLister, projectID string) ([]string, error) {
@@ -513,6 +593,10 @@ func fetchClusterNames(clustersProvider store.AllClustersLister, projectID strin
 }
 
 func (e *ConfigExporter) exportDataFederation(projectName string) ([]runtime.Object, error) {
+	if !e.resourceSelection.IsSelected(features.ResourceAtlasDataFederation) {
+		return nil, nil
+	}
+
 	nameList := e.dataFederationNames
 	if len(nameList) == 0 {
 		dataFederations, err := e.fetchDataFederationNames()
@@ -521,13 +605,16 @@ func (e *ConfigExporter) exportDataFederation(projectName string) ([]runtime.Obj
 		}
 		nameList = dataFederations
 	}
+	atlasDataFederations, err := runConcurrently(e.maxWorkers, nameList, func(name string) (*akov2.AtlasDataFederation, error) {
+		return datafederation.BuildAtlasDataFederation(e.dataProvider, name, e.projectID, projectName, e.operatorVersion, e.targetNamespace, e.dictionaryForAtlasNames)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	result := make([]runtime.Object, 0, len(nameList))
-	for _, name := range nameList {
-		atlasDataFederations, err := datafederation.BuildAtlasDataFederation(e.dataProvider, name, e.projectID, projectName, e.operatorVersion, e.targetNamespace, e.dictionaryForAtlasNames)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, atlasDataFederations)
+	for _, atlasDataFederation := range atlasDataFederations {
+		result = append(result, atlasDataFederation)
 	}
 	return result, nil
 }
@@ -554,15 +641,24 @@ func (e *ConfigExporter) exportAtlasStreamProcessing(projectName string) ([]runt
 		return nil, nil
 	}
 
+	exportInstances := e.resourceSelection.IsSelected(features.ResourceAtlasStreamInstance)
+	exportConnections := e.resourceSelection.IsSelected(features.ResourceAtlasStreamConnection)
+	if !exportInstances && !exportConnections {
+		return nil, nil
+	}
+
 	instancesList, err := e.dataProvider.ProjectStreams(e.projectID)
 	if err != nil {
 		return nil, err
 	}
-	instances := instancesList
-	result := make([]runtime.Object, 0, len(instances))
 
-	for i := range instances {
-		instance := instances[i]
+	// unlike the other independent resources, AtlasStreamInstance has no external project reference
+	if e.independentResources && exportInstances && len(instancesList) > 0 {
+		_, _ = log.Warningf("Stream instances of project %s reference its AtlasProject resource, "+
+			"AtlasStreamInstance can not reference a project by ID\n", projectName)
+	}
+
+	instances, err := runConcurrently(e.maxWorkers, instancesList, func(instance admin.StreamsTenant) ([]runtime.Object, error) {
 		connectionsList, err := e.dataProvider.StreamsConnections(e.projectID, instance.GetName())
 		if err != nil {
 			return nil, err
@@ -574,13 +670,21 @@ func (e *ConfigExporter) exportAtlasStreamProcessing(projectName string) ([]runt
 			projectName,
 			&instance,
 			connectionsList.GetResults(),
+			e.includeSecretsData,
 			e.dictionaryForAtlasNames,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		result = append(result, akoInstance)
+		result := make([]runtime.Object, 0, 1+len(akoConnections)+len(akoSecrets))
+		if exportInstances {
+			result = append(result, akoInstance)
+		}
+
+		if !exportConnections {
+			return result, nil
+		}
 
 		for x := range akoConnections {
 			result = append(result, akoConnections[x])
@@ -589,13 +693,23 @@ func (e *ConfigExporter) exportAtlasStreamProcessing(projectName string) ([]runt
 		for x := range akoSecrets {
 			result = append(result, akoSecrets[x])
 		}
+
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]runtime.Object, 0, len(instances))
+	for _, objects := range instances {
+		result = append(result, objects...)
 	}
 
 	return result, nil
 }
 
 func (e *ConfigExporter) exportAtlasOrgSettings(orgId string) ([]runtime.Object, error) {
-	if !e.featureValidator.IsResourceSupported(features.ResourceAtlasOrgSettings) {
+	if !e.isExported(features.ResourceAtlasOrgSettings) {
 		return nil, nil
 	}
 
@@ -612,7 +726,7 @@ func (e *ConfigExporter) exportAtlasOrgSettings(orgId string) ([]runtime.Object,
 }
 
 func (e *ConfigExporter) exportAtlasFederatedAuth(projectName string) ([]runtime.Object, error) {
-	if !e.featureValidator.IsResourceSupported(features.ResourceAtlasFederatedAuth) {
+	if !e.isExported(features.ResourceAtlasFederatedAuth) {
 		return nil, nil
 	}
 	result := make([]runtime.Object, 0)
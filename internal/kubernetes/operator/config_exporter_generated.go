@@ -17,13 +17,13 @@ package operator
 import (
 	"bytes"
 	"context"
+	stdjson "encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/crd2go/crd2go/k8s"
-	"github.com/google/uuid"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
@@ -106,7 +106,6 @@ func (e *GeneratedExporter) ShouldIncludeSecrets() bool {
 // delegates type conversion to the Crapi translator,
 // and serializes the CRD objects to YAML format.
 func (e *GeneratedExporter) Run() (string, error) {
-	ctx := context.Background()
 	output := bytes.NewBufferString(yamlSeparator)
 
 	serializer := json.NewSerializerWithOptions(
@@ -116,14 +115,59 @@ func (e *GeneratedExporter) Run() (string, error) {
 		json.SerializerOptions{Yaml: true, Pretty: true},
 	)
 
+	objects, err := e.Export()
+	if err != nil {
+		return "", err
+	}
+
+	for _, obj := range objects {
+		if err := serializer.Encode(obj, output); err != nil {
+			return "", fmt.Errorf("failed to serialize resource: %w", err)
+		}
+		output.WriteString(yamlSeparator)
+	}
+
+	return output.String(), nil
+}
+
+// Export returns the credentials secret, when secrets are included, followed by
+// the exported resources without their status.
+func (e *GeneratedExporter) Export() ([]runtime.Object, error) {
+	objects, err := e.exportObjects(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		if secret, ok := obj.(*corev1.Secret); ok {
+			result = append(result, secret)
+			continue
+		}
+
+		// Convert to unstructured and remove status for serialization only
+		// (keeps the concrete object intact for cross-resource references)
+		unstructuredObj, err := toUnstructuredWithoutStatus(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare resource for serialization: %w", err)
+		}
+		result = append(result, unstructuredObj)
+	}
+
+	return result, nil
+}
+
+// exportObjects returns the credentials secret, when secrets are included, followed by
+// the resources of every exporter. Exporters run in order, so referenced objects always
+// precede the objects referencing them.
+func (e *GeneratedExporter) exportObjects(ctx context.Context) ([]client.Object, error) {
+	var result []client.Object
+
 	// Create credentials secret if needed
 	var credentialsSecret *corev1.Secret
 	if e.ShouldIncludeSecrets() {
 		credentialsSecret = e.buildCredentialsSecret()
-		if err := serializer.Encode(credentialsSecret, output); err != nil {
-			return "", fmt.Errorf("failed to serialize credentials secret: %w", err)
-		}
-		output.WriteString(yamlSeparator)
+		result = append(result, credentialsSecret)
 	}
 
 	// Export all resources from all exporters
@@ -138,7 +182,7 @@ func (e *GeneratedExporter) Run() (string, error) {
 
 		objects, err := exp.Export(ctx, referencedObjects)
 		if err != nil {
-			return "", fmt.Errorf("failed to export resources: %w", err)
+			return nil, fmt.Errorf("failed to export resources: %w", err)
 		}
 
 		for _, obj := range objects {
@@ -154,27 +198,17 @@ func (e *GeneratedExporter) Run() (string, error) {
 			if credentialsSecret != nil {
 				err = setConnectionSecretRef(obj, credentialsSecret.Name)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 			}
-
-			// Convert to unstructured and remove status for serialization only
-			// (keeps the concrete object intact for cross-resource references)
-			unstructuredObj, err := toUnstructuredWithoutStatus(obj)
-			if err != nil {
-				return "", fmt.Errorf("failed to prepare resource for serialization: %w", err)
-			}
-
-			if err := serializer.Encode(unstructuredObj, output); err != nil {
-				return "", fmt.Errorf("failed to serialize resource: %w", err)
-			}
-			output.WriteString(yamlSeparator)
 		}
 
+		// sort within each exporter only, objects of later exporters may reference earlier ones
+		sortObjects(objects)
 		exportedObjects = append(exportedObjects, objects...)
 	}
 
-	return output.String(), nil
+	return append(result, exportedObjects...), nil
 }
 
 // buildCredentialsSecret creates a Kubernetes Secret containing Atlas API credentials.
@@ -182,25 +216,11 @@ func (e *GeneratedExporter) buildCredentialsSecret() *corev1.Secret {
 	secretName := credentialsSecretName
 	dictionary := resources.AtlasNameToKubernetesName()
 
-	secretBuilder := secrets.NewAtlasSecretBuilder(secretName, e.targetNamespace, dictionary)
-
-	// Include actual credentials data if the credentials provider is available
-	if e.credentialsProvider != nil && e.includeSecrets {
-		secretBuilder = secretBuilder.WithData(map[string][]byte{
-			secrets.CredOrgID:         []byte(e.orgID),
-			secrets.CredPublicAPIKey:  []byte(e.credentialsProvider.PublicAPIKey()),
-			secrets.CredPrivateAPIKey: []byte(e.credentialsProvider.PrivateAPIKey()),
-		})
-	} else {
-		// Create empty secret placeholders
-		secretBuilder = secretBuilder.WithData(map[string][]byte{
-			secrets.CredOrgID:         []byte(""),
-			secrets.CredPublicAPIKey:  []byte(""),
-			secrets.CredPrivateAPIKey: []byte(""),
-		})
-	}
-
-	return secretBuilder.Build()
+	// Credentials are only included when the credentials provider is available,
+	// otherwise the secret holds empty placeholders
+	return secrets.NewAtlasSecretBuilder(secretName, e.targetNamespace, dictionary).
+		WithData(secrets.CredentialsData(e.credentialsProvider, e.orgID, e.includeSecrets)).
+		Build()
 }
 
 // setConnectionSecretRef sets the ConnectionSecretRef field on a resource using reflection.
@@ -241,9 +261,9 @@ func setResourceName(obj client.Object) {
 	// Extract identifying information from the spec
 	identifier := extractNameIdentifier(obj)
 
-	// If no identifier found, generate a random one
+	// If no identifier found, derive a stable one from the Atlas ID
 	if len(identifier) == 0 {
-		identifier = uuid.NewString()[:7]
+		identifier = resources.HashSuffix(externalID(obj))
 	}
 
 	// Build hierarchical name: kind-identifier1-identifier2...
@@ -254,6 +274,25 @@ func setResourceName(obj client.Object) {
 	obj.SetName(name)
 }
 
+// externalID returns the Atlas ID of the object or, when the exporter did not record it,
+// the serialized spec, which identifies the resource just as deterministically.
+func externalID(obj client.Object) string {
+	if id, ok := obj.GetAnnotations()[generated.ExternalIDAnnotation]; ok && id != "" {
+		return id
+	}
+
+	spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return obj.GetName()
+	}
+	data, err := stdjson.Marshal(spec["spec"])
+	if err != nil {
+		return obj.GetName()
+	}
+
+	return string(data)
+}
+
 // extractIdentifier extracts identifying fields from the object's spec.
 // It uses reflection to navigate the versioned spec structure and extract
 // the resource name (Name, Username, ... fields).
@@ -27,6 +27,7 @@ import (
 	atlasauth "go.mongodb.org/atlas/auth"
 
 	generated "github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter/generated"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -308,3 +309,59 @@ func TestGeneratedExporterRun(t *testing.T) {
 		})
 	}
 }
+
+func TestSetResourceName(t *testing.T) {
+	t.Run("should derive the name from the spec", func(t *testing.T) {
+		group := newGroup("My Project", "org-id")
+		setResourceName(group)
+		assert.Equal(t, "group-my-project", group.GetName())
+	})
+
+	t.Run("should derive a stable name from the external id when the spec has no name", func(t *testing.T) {
+		newUnnamedGroup := func(externalID string) *samplesv1.Group {
+			group := newGroup("", "org-id")
+			group.SetAnnotations(map[string]string{generated.ExternalIDAnnotation: externalID})
+			return group
+		}
+
+		first, second, other := newUnnamedGroup("project-id"), newUnnamedGroup("project-id"), newUnnamedGroup("other-project-id")
+		setResourceName(first)
+		setResourceName(second)
+		setResourceName(other)
+
+		assert.Equal(t, "group-"+resources.HashSuffix("project-id"), first.GetName())
+		assert.Equal(t, first.GetName(), second.GetName())
+		assert.NotEqual(t, first.GetName(), other.GetName())
+	})
+
+	t.Run("should derive a stable name from the spec when there is no external id", func(t *testing.T) {
+		first, second := newGroup("", "org-id"), newGroup("", "org-id")
+		setResourceName(first)
+		setResourceName(second)
+
+		assert.Equal(t, first.GetName(), second.GetName())
+	})
+}
+
+func TestGeneratedExporterExportOrder(t *testing.T) {
+	exp := NewGeneratedExporter(GeneratedExporterConfig{
+		TargetNamespace: "test",
+		Scheme:          newTestScheme(t),
+		Exporters: []generated.Exporter{
+			&mockExporter{objects: []client.Object{newGroup("project", "org-id")}},
+			&mockExporter{objects: []client.Object{
+				newFlexCluster("b-cluster", "group-id", "AWS", "US_EAST_1"),
+				newFlexCluster("a-cluster", "group-id", "AWS", "US_EAST_1"),
+			}},
+		},
+	})
+
+	objects, err := exp.exportObjects(context.Background())
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		names = append(names, obj.GetName())
+	}
+	assert.Equal(t, []string{"group-project", "flexcluster-a-cluster", "flexcluster-b-cluster"}, names)
+}
@@ -17,13 +17,16 @@
 package operator
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
 	"github.com/go-test/deep"
 	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
 	akoapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
@@ -198,7 +201,7 @@ func TestExportAtlasStreamProcessing(t *testing.T) {
 		assert.Nil(t, resources)
 	})
 
-	t.Run("should return error when fail to build resources", func(t *testing.T) {
+	t.Run("should skip connections of unsupported types", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		atlasOperatorGenericStore := mocks.NewMockOperatorGenericStore(ctl)
 		atlasOperatorGenericStore.EXPECT().
@@ -227,8 +230,11 @@ func TestExportAtlasStreamProcessing(t *testing.T) {
 			WithFeatureValidator(featureValidator)
 
 		resources, err := ce.exportAtlasStreamProcessing("my-project")
-		require.ErrorContains(t, err, "trying to generate an unsupported connection type")
-		assert.Nil(t, resources)
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		instance, ok := resources[0].(*akov2.AtlasStreamInstance)
+		require.True(t, ok)
+		assert.Empty(t, instance.Spec.ConnectionRegistry)
 	})
 
 	t.Run("should return exported resources", func(t *testing.T) {
@@ -291,7 +297,8 @@ func TestExportAtlasStreamProcessing(t *testing.T) {
 		ce := NewConfigExporter(atlasOperatorGenericStore, nil, projectID, orgID).
 			WithFeatureValidator(featureValidator).
 			WithTargetNamespace("test").
-			WithTargetOperatorVersion(features.LatestOperatorMajorVersion)
+			WithTargetOperatorVersion(features.LatestOperatorMajorVersion).
+			WithSecretsData(true)
 
 		resources, err := ce.exportAtlasStreamProcessing("my-project")
 		require.NoError(t, err)
@@ -722,6 +729,7 @@ func TestExportAtlasOrgSettings(t *testing.T) {
 					GetOrgSettings(orgID).
 					Return(orgSettings, nil)
 
+				credsProvider.EXPECT().AuthType().Return(config.APIKeys)
 				credsProvider.EXPECT().PublicAPIKey().Return("test-public-key")
 				credsProvider.EXPECT().PrivateAPIKey().Return("test-private-key")
 			},
@@ -798,3 +806,185 @@ func TestExportAtlasOrgSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestExportWithResourceSelection(t *testing.T) {
+	selection, err := features.NewResourceSelection([]string{"AtlasDatabaseUser", "AtlasStreamInstance"}, nil)
+	require.NoError(t, err)
+
+	t.Run("should not read resources that are not selected", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		atlasOperatorGenericStore := mocks.NewMockOperatorGenericStore(ctl)
+		featureValidator := mocks.NewMockFeatureValidator(ctl)
+
+		ce := NewConfigExporter(atlasOperatorGenericStore, nil, projectID, orgID).
+			WithFeatureValidator(featureValidator).
+			WithResourceSelection(selection)
+
+		deployments, err := ce.exportDeployments("my-project")
+		require.NoError(t, err)
+		assert.Nil(t, deployments)
+
+		dataFederations, err := ce.exportDataFederation("my-project")
+		require.NoError(t, err)
+		assert.Nil(t, dataFederations)
+
+		federatedAuth, err := ce.exportAtlasFederatedAuth("my-project")
+		require.NoError(t, err)
+		assert.Nil(t, federatedAuth)
+
+		orgSettings, err := ce.exportAtlasOrgSettings(orgID)
+		require.NoError(t, err)
+		assert.Nil(t, orgSettings)
+	})
+
+	t.Run("should only export selected stream resources", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		atlasOperatorGenericStore := mocks.NewMockOperatorGenericStore(ctl)
+		atlasOperatorGenericStore.EXPECT().
+			ProjectStreams(projectID).
+			Return([]admin.StreamsTenant{{Name: pointer.Get("instance-0")}}, nil)
+		atlasOperatorGenericStore.EXPECT().
+			StreamsConnections(projectID, "instance-0").
+			Return(&admin.PaginatedApiStreamsConnection{
+				Results: &[]admin.StreamsConnection{
+					{Name: pointer.Get("sample_stream_solar"), Type: pointer.Get("Sample")},
+				},
+			}, nil)
+
+		featureValidator := mocks.NewMockFeatureValidator(ctl)
+		featureValidator.EXPECT().
+			IsResourceSupported(features.ResourceAtlasStreamInstance).
+			Return(true)
+		featureValidator.EXPECT().
+			IsResourceSupported(features.ResourceAtlasStreamConnection).
+			Return(true)
+
+		ce := NewConfigExporter(atlasOperatorGenericStore, nil, projectID, orgID).
+			WithFeatureValidator(featureValidator).
+			WithTargetOperatorVersion(features.LatestOperatorMajorVersion).
+			WithResourceSelection(selection)
+
+		resources, err := ce.exportAtlasStreamProcessing("my-project")
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		assert.IsType(t, &akov2.AtlasStreamInstance{}, resources[0])
+	})
+}
+
+func TestExportWithoutSecretsData(t *testing.T) {
+	selection, err := features.NewResourceSelection([]string{
+		"AtlasProject", "AtlasThirdPartyIntegration", "AtlasStreamInstance", "AtlasStreamConnection", "AtlasOrgSettings",
+	}, nil)
+	require.NoError(t, err)
+
+	ctl := gomock.NewController(t)
+	store := mocks.NewMockOperatorGenericStore(ctl)
+	store.EXPECT().
+		Project(projectID).
+		Return(&admin.Group{Id: pointer.Get(projectID), Name: "my-project", OrgId: orgID}, nil)
+	store.EXPECT().
+		Integrations(projectID).
+		Return([]admin.ThirdPartyIntegration{
+			{Type: pointer.Get("DATADOG"), ApiKey: pointer.Get("datadog-api-key"), Region: pointer.Get("US")},
+			{Type: pointer.Get("VICTOR_OPS"), ApiKey: pointer.Get("victorops-api-key"), RoutingKey: pointer.Get("routing-key")},
+			{Type: pointer.Get("NEW_RELIC"), AccountId: pointer.Get("account-id"), LicenseKey: pointer.Get("license-key")},
+		}, nil)
+	store.EXPECT().
+		ProjectStreams(projectID).
+		Return([]admin.StreamsTenant{{Name: pointer.Get("instance-0")}}, nil)
+	store.EXPECT().
+		StreamsConnections(projectID, "instance-0").
+		Return(&admin.PaginatedApiStreamsConnection{
+			Results: &[]admin.StreamsConnection{
+				{
+					Name:             pointer.Get("kafka"),
+					Type:             pointer.Get("Kafka"),
+					BootstrapServers: pointer.Get("kafka:9092"),
+					Authentication: &admin.StreamsKafkaAuthentication{
+						Mechanism: pointer.Get("PLAIN"),
+						Username:  pointer.Get("kafka-user"),
+					},
+					Security: &admin.StreamsKafkaSecurity{
+						Protocol:                pointer.Get("SSL"),
+						BrokerPublicCertificate: pointer.Get("kafka-certificate"),
+					},
+				},
+			},
+		}, nil)
+	store.EXPECT().
+		GetOrgSettings(orgID).
+		Return(&admin.OrganizationSettings{}, nil)
+
+	featureValidator := mocks.NewMockFeatureValidator(ctl)
+	featureValidator.EXPECT().FeatureExist(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	featureValidator.EXPECT().IsResourceSupported(gomock.Any()).Return(true).AnyTimes()
+
+	credsProvider := mocks.NewMockCredentialsGetter(ctl)
+	credsProvider.EXPECT().AuthType().Return(config.APIKeys).AnyTimes()
+	credsProvider.EXPECT().PublicAPIKey().Return("public-key").AnyTimes()
+	credsProvider.EXPECT().PrivateAPIKey().Return("private-key").AnyTimes()
+
+	objects, err := NewConfigExporter(store, credsProvider, projectID, orgID).
+		WithTargetNamespace("test").
+		WithTargetOperatorVersion(features.LatestOperatorMajorVersion).
+		WithFeatureValidator(featureValidator).
+		WithResourceSelection(selection).
+		WithSecretsData(false).
+		Export()
+	require.NoError(t, err)
+
+	exportedSecrets := 0
+	for _, obj := range objects {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			continue
+		}
+		exportedSecrets++
+		for key, value := range secret.Data {
+			assert.Empty(t, value, "key %s of secret %s holds data", key, secret.Name)
+		}
+		for key, value := range secret.StringData {
+			assert.Empty(t, value, "key %s of secret %s holds data", key, secret.Name)
+		}
+	}
+	assert.Positive(t, exportedSecrets)
+}
+
+func TestExportAtlasStreamProcessingWithIndependentResources(t *testing.T) {
+	ctl := gomock.NewController(t)
+	atlasOperatorGenericStore := mocks.NewMockOperatorGenericStore(ctl)
+	atlasOperatorGenericStore.EXPECT().
+		ProjectStreams(projectID).
+		Return([]admin.StreamsTenant{{Name: pointer.Get("instance-0")}}, nil)
+	atlasOperatorGenericStore.EXPECT().
+		StreamsConnections(projectID, "instance-0").
+		Return(&admin.PaginatedApiStreamsConnection{
+			Results: &[]admin.StreamsConnection{
+				{Name: pointer.Get("sample_stream_solar"), Type: pointer.Get("Sample")},
+				{Name: pointer.Get("webhook"), Type: pointer.Get("Https")},
+			},
+		}, nil)
+
+	featureValidator := mocks.NewMockFeatureValidator(ctl)
+	featureValidator.EXPECT().IsResourceSupported(gomock.Any()).Return(true).AnyTimes()
+
+	warnings := &bytes.Buffer{}
+	defaultWriter := log.Writer()
+	log.SetWriter(warnings)
+	t.Cleanup(func() { log.SetWriter(defaultWriter) })
+
+	ce := NewConfigExporter(atlasOperatorGenericStore, nil, projectID, orgID).
+		WithFeatureValidator(featureValidator).
+		WithTargetNamespace("test").
+		WithTargetOperatorVersion(features.LatestOperatorMajorVersion).
+		WithIndependentResources(true)
+
+	resources, err := ce.exportAtlasStreamProcessing("my-project")
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.IsType(t, &akov2.AtlasStreamInstance{}, resources[0])
+	assert.IsType(t, &akov2.AtlasStreamConnection{}, resources[1])
+
+	assert.Contains(t, warnings.String(), "Skipping connection webhook of stream instance instance-0: trying to generate an unsupported connection type Https")
+	assert.Contains(t, warnings.String(), "Stream instances of project my-project reference its AtlasProject resource")
+}
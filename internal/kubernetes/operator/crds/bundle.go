@@ -0,0 +1,52 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crds
+
+import (
+	"embed"
+	"errors"
+
+	"github.com/spf13/afero"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// bundle holds a snapshot of the released CRDs, refreshed with `make gen-crds`.
+//
+//go:embed bundle
+var bundle embed.FS
+
+// BundledAtlasCRDProvider serves the CRDs bundled with the plugin. CRDs missing from the
+// bundle, for instance those of a version released after the plugin, are requested from
+// the fallback provider when there is one.
+type BundledAtlasCRDProvider struct {
+	bundle   *LocalAtlasCRDProvider
+	fallback AtlasOperatorCRDProvider
+}
+
+func NewBundledAtlasCRDProvider(fallback AtlasOperatorCRDProvider) *BundledAtlasCRDProvider {
+	return &BundledAtlasCRDProvider{
+		bundle:   &LocalAtlasCRDProvider{fs: &afero.FromIOFS{FS: bundle}, dir: "bundle"},
+		fallback: fallback,
+	}
+}
+
+func (p *BundledAtlasCRDProvider) GetAtlasOperatorResource(resourceName, version string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	crd, err := p.bundle.GetAtlasOperatorResource(resourceName, version)
+	if errors.Is(err, ErrCRDNotFound) && p.fallback != nil {
+		return p.fallback.GetAtlasOperatorResource(resourceName, version)
+	}
+
+	return crd, err
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crds
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var ErrCRDNotFound = errors.New("CRD not found")
+
+// LocalAtlasCRDProvider reads CRDs from a directory, or from a tarball, holding one
+// <resource>.yaml file per CRD either in a v<version> directory or at its root.
+type LocalAtlasCRDProvider struct {
+	fs  afero.Fs
+	dir string
+}
+
+// NewLocalAtlasCRDProvider reads CRDs from the given directory or tarball, compressed with gzip or not.
+func NewLocalAtlasCRDProvider(fs afero.Fs, crdsPath string) (*LocalAtlasCRDProvider, error) {
+	info, err := fs.Stat(crdsPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not read CRDs from %s: %w", crdsPath, err)
+	}
+
+	if info.IsDir() {
+		return &LocalAtlasCRDProvider{fs: fs, dir: crdsPath}, nil
+	}
+
+	tarballFs, err := extractTarball(fs, crdsPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not read CRDs from %s: %w", crdsPath, err)
+	}
+
+	return &LocalAtlasCRDProvider{fs: tarballFs, dir: "/"}, nil
+}
+
+func (p *LocalAtlasCRDProvider) GetAtlasOperatorResource(resourceName, version string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	dir := filepath.ToSlash(p.dir)
+	fileName := resourceName + ".yaml"
+
+	for _, filePath := range []string{path.Join(dir, "v"+version, fileName), path.Join(dir, fileName)} {
+		data, err := afero.ReadFile(p.fs, filePath)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		decoded := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, decoded); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", filePath, err)
+		}
+		return decoded, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s for version %s in %s", ErrCRDNotFound, resourceName, version, p.dir)
+}
+
+// extractTarball copies the regular files of a tarball into an in-memory filesystem.
+func extractTarball(fs afero.Fs, tarballPath string) (afero.Fs, error) {
+	file, err := fs.Open(tarballPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var archive io.Reader = reader
+	// gzip streams start with the 0x1f 0x8b magic number
+	if magic, err := reader.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		archive = gzipReader
+	}
+
+	result := afero.NewMemMapFs()
+	tarReader := tar.NewReader(archive)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, err
+		}
+		if err := afero.WriteFile(result, path.Join("/", header.Name), data, 0o600); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
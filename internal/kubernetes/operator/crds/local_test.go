@@ -0,0 +1,117 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package crds
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const (
+	testResource = "atlas.mongodb.com_atlasprojects"
+	testCRD      = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: atlasprojects.atlas.mongodb.com
+spec:
+  group: atlas.mongodb.com
+`
+)
+
+func newTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return buf.Bytes()
+}
+
+func TestLocalAtlasCRDProvider(t *testing.T) {
+	t.Run("should read CRDs from a version directory", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, "crds/v2.13.0/"+testResource+".yaml", []byte(testCRD), 0o600))
+
+		provider, err := NewLocalAtlasCRDProvider(fs, "crds")
+		require.NoError(t, err)
+
+		crd, err := provider.GetAtlasOperatorResource(testResource, "2.13.0")
+		require.NoError(t, err)
+		assert.Equal(t, "atlasprojects.atlas.mongodb.com", crd.Name)
+
+		_, err = provider.GetAtlasOperatorResource(testResource, "2.14.0")
+		require.ErrorIs(t, err, ErrCRDNotFound)
+	})
+
+	t.Run("should read CRDs from the root of a tarball", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, "crds.tar.gz", newTarball(t, map[string]string{testResource + ".yaml": testCRD}), 0o600))
+
+		provider, err := NewLocalAtlasCRDProvider(fs, "crds.tar.gz")
+		require.NoError(t, err)
+
+		crd, err := provider.GetAtlasOperatorResource(testResource, "2.14.0")
+		require.NoError(t, err)
+		assert.Equal(t, "atlasprojects.atlas.mongodb.com", crd.Name)
+	})
+
+	t.Run("should fail when the path does not exist", func(t *testing.T) {
+		_, err := NewLocalAtlasCRDProvider(afero.NewMemMapFs(), "missing")
+		require.ErrorContains(t, err, "can not read CRDs from missing")
+	})
+}
+
+type fakeProvider struct {
+	requested []string
+}
+
+func (p *fakeProvider) GetAtlasOperatorResource(resourceName, version string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	p.requested = append(p.requested, resourceName+"@"+version)
+	return &apiextensionsv1.CustomResourceDefinition{}, nil
+}
+
+func TestBundledAtlasCRDProvider(t *testing.T) {
+	fallback := &fakeProvider{}
+	provider := NewBundledAtlasCRDProvider(fallback)
+
+	crd, err := provider.GetAtlasOperatorResource(testResource, "2.14.0")
+	require.NoError(t, err)
+	assert.Equal(t, "atlasprojects.atlas.mongodb.com", crd.Name)
+	assert.Empty(t, fallback.requested)
+
+	_, err = provider.GetAtlasOperatorResource(testResource, "9.9.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{testResource + "@9.9.0"}, fallback.requested)
+
+	_, err = NewBundledAtlasCRDProvider(nil).GetAtlasOperatorResource(testResource, "9.9.0")
+	require.ErrorIs(t, err, ErrCRDNotFound)
+}
@@ -15,12 +15,14 @@
 package dbusers
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
-	"github.com/google/uuid"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
 	akoapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
@@ -32,7 +34,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const timeFormatISO8601 = "2006-01-02T15:04:05.999Z"
+const (
+	timeFormatISO8601 = "2006-01-02T15:04:05.999Z"
+	authTypeNone      = "NONE"
+)
 
 func BuildDBUsers(provider store.OperatorDBUsersStore, projectID, projectName, targetNamespace, credentials string, dictionary map[string]string, version string, independentResource bool) ([]*akov2.AtlasDatabaseUser, []*corev1.Secret, error) {
 	users, err := provider.DatabaseUsers(projectID)
@@ -44,12 +49,25 @@ func BuildDBUsers(provider store.OperatorDBUsersStore, projectID, projectName, t
 		return nil, nil, nil
 	}
 
+	// Atlas does not guarantee the order of users, sort them so colliding names are resolved the same way on every run
+	users = slices.Clone(users)
+	slices.SortFunc(users, func(a, b atlasv2.CloudDatabaseUser) int {
+		return cmp.Or(cmp.Compare(a.Username, b.Username), cmp.Compare(a.DatabaseName, b.DatabaseName))
+	})
+
 	mappedUsers := map[string]*akov2.AtlasDatabaseUser{}
+	result := make([]*akov2.AtlasDatabaseUser, 0, len(users))
 	relatedSecrets := make([]*corev1.Secret, 0, len(users))
 
 	for _, u := range users {
 		user := pointer.Get(u)
-		resourceName := suggestResourceName(projectName, user.Username, mappedUsers, dictionary)
+		// AtlasDatabaseUser has no field for LDAP, exporting the user would turn it into a password user
+		if isExternalAuthType(user.GetLdapAuthType()) {
+			_, _ = log.Warningf("Skipping database user %s, LDAP authentication is not supported by Atlas Kubernetes Operator\n", user.Username)
+			continue
+		}
+
+		resourceName := suggestResourceName(projectName, user, mappedUsers, dictionary)
 		labels := convertUserLabels(user)
 		roles := convertUserRoles(user)
 		if len(roles) == 0 {
@@ -76,7 +94,9 @@ func BuildDBUsers(provider store.OperatorDBUsersStore, projectID, projectName, t
 				Roles:           roles,
 				Scopes:          scopes,
 				Username:        user.Username,
-				X509Type:        *user.X509Type,
+				OIDCAuthType:    user.GetOidcAuthType(),
+				AWSIAMType:      user.GetAwsIAMType(),
+				X509Type:        user.GetX509Type(),
 			},
 			Status: akov2status.AtlasDatabaseUserStatus{
 				Common: akoapi.Common{
@@ -87,8 +107,9 @@ func BuildDBUsers(provider store.OperatorDBUsersStore, projectID, projectName, t
 		normalizedProjectName := resources.NormalizeAtlasName(projectName, dictionary)
 		dbu = setReference(dbu, independentResource, projectID, normalizedProjectName, targetNamespace, credentials, dictionary)
 		mappedUsers[resourceName] = dbu
+		result = append(result, dbu)
 
-		if user.GetX509Type() != "MANAGED" {
+		if isPasswordUser(user) {
 			secret := buildUserSecret(resourceName, targetNamespace, projectID, projectName, dictionary)
 			relatedSecrets = append(relatedSecrets, secret)
 
@@ -98,14 +119,21 @@ func BuildDBUsers(provider store.OperatorDBUsersStore, projectID, projectName, t
 		}
 	}
 
-	result := make([]*akov2.AtlasDatabaseUser, 0, len(users))
-	for _, mappedUser := range mappedUsers {
-		result = append(result, mappedUser)
-	}
-
 	return result, relatedSecrets, nil
 }
 
+// isPasswordUser tells whether the user authenticates with SCRAM, the only method needing a password secret.
+func isPasswordUser(user *atlasv2.CloudDatabaseUser) bool {
+	return !isExternalAuthType(user.GetX509Type()) &&
+		!isExternalAuthType(user.GetAwsIAMType()) &&
+		!isExternalAuthType(user.GetLdapAuthType()) &&
+		!isExternalAuthType(user.GetOidcAuthType())
+}
+
+func isExternalAuthType(authType string) bool {
+	return authType != "" && authType != authTypeNone
+}
+
 func setReference(dbUser *akov2.AtlasDatabaseUser, independentResource bool, projectID, projectName, namespace string, credentials string, dictionary map[string]string) *akov2.AtlasDatabaseUser {
 	if independentResource {
 		dbUser.Spec.ExternalProjectRef = &akov2.ExternalProjectReference{
@@ -175,18 +203,21 @@ func convertUserLabels(user *atlasv2.CloudDatabaseUser) []akov2common.LabelSpec
 	return result
 }
 
+// suggestResourceName returns the normalized name of the user, suffixed with a hash of the
+// user's database and username when the name collides with an already exported user.
 func suggestResourceName(
 	projectName string,
-	username string,
+	user *atlasv2.CloudDatabaseUser,
 	mappedDatabaseUsers map[string]*akov2.AtlasDatabaseUser,
 	dictionary map[string]string,
 ) string {
-	resourceName := resources.NormalizeAtlasName(fmt.Sprintf("%s-%s", projectName, username), dictionary)
+	resourceName := resources.NormalizeAtlasName(fmt.Sprintf("%s-%s", projectName, user.Username), dictionary)
 	_, ok := mappedDatabaseUsers[resourceName]
 
+	id := fmt.Sprintf("%s:%s", user.DatabaseName, user.Username)
 	for ok {
-		suffix := uuid.NewString()[:5]
-		resourceName = fmt.Sprintf("%s-%s", resourceName, suffix)
+		resourceName = resources.AppendHashSuffix(resourceName, id)
+		id = resourceName
 
 		_, ok = mappedDatabaseUsers[resourceName]
 	}
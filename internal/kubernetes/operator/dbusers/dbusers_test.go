@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -36,6 +37,7 @@ import (
 	akov2common "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
 	akov2status "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	atlasv2 "go.mongodb.org/atlas-sdk/v20250312006/admin"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -150,9 +152,9 @@ func TestBuildDBUsers(t *testing.T) {
 					Value: pointer.Get("TestLabelValue"),
 				},
 			},
-			LdapAuthType: pointer.Get("TestType"),
-			X509Type:     pointer.Get("TestX509"),
-			AwsIAMType:   pointer.Get("TestAWSIAMType"),
+			LdapAuthType: pointer.Get("NONE"),
+			X509Type:     pointer.Get("NONE"),
+			AwsIAMType:   pointer.Get("NONE"),
 			GroupId:      "0",
 			Roles: &[]atlasv2.DatabaseUserRole{
 				{
@@ -222,8 +224,9 @@ func TestBuildDBUsers(t *testing.T) {
 				PasswordSecret: &akov2common.ResourceRef{
 					Name: relatedSecrets[0].Name,
 				},
-				Username: user.Username,
-				X509Type: *user.X509Type,
+				Username:   user.Username,
+				AWSIAMType: *user.AwsIAMType,
+				X509Type:   *user.X509Type,
 			},
 			Status: akov2status.AtlasDatabaseUserStatus{
 				Common: akoapi.Common{
@@ -268,9 +271,9 @@ func TestBuildDBUsers(t *testing.T) {
 						Value: pointer.Get("TestLabelValue"),
 					},
 				},
-				LdapAuthType: pointer.Get("TestType"),
-				X509Type:     pointer.Get("TestX509"),
-				AwsIAMType:   pointer.Get("TestAWSIAMType"),
+				LdapAuthType: pointer.Get("NONE"),
+				X509Type:     pointer.Get("NONE"),
+				AwsIAMType:   pointer.Get("NONE"),
 				GroupId:      "0",
 				Roles: &[]atlasv2.DatabaseUserRole{
 					{
@@ -297,9 +300,9 @@ func TestBuildDBUsers(t *testing.T) {
 						Value: pointer.Get("TestLabelValue"),
 					},
 				},
-				LdapAuthType: pointer.Get("TestType"),
-				X509Type:     pointer.Get("TestX509"),
-				AwsIAMType:   pointer.Get("TestAWSIAMType"),
+				LdapAuthType: pointer.Get("NONE"),
+				X509Type:     pointer.Get("NONE"),
+				AwsIAMType:   pointer.Get("NONE"),
 				GroupId:      "0",
 				Roles: &[]atlasv2.DatabaseUserRole{
 					{
@@ -329,5 +332,122 @@ func TestBuildDBUsers(t *testing.T) {
 
 		assert.NotEqual(t, users[0].Name, users[1].Name)
 		assert.NotEqual(t, relatedSecrets[0].Name, relatedSecrets[1].Name)
+
+		expectedName := resources.NormalizeAtlasName(fmt.Sprintf("%s-%s", projectName, "TestUsername"), dictionary)
+		assert.Equal(t, expectedName, users[0].Name)
+		assert.Equal(t, resources.AppendHashSuffix(expectedName, "TestDB:testUsername"), users[1].Name)
+
+		// the result must not depend on the order Atlas returns users in
+		slices.Reverse(atlasUsers)
+		mockUserStore.EXPECT().DatabaseUsers(projectID).Return(atlasUsers, nil)
+		reversedUsers, reversedSecrets, err := BuildDBUsers(mockUserStore, projectID, projectName, targetNamespace, creds, dictionary, resourceVersion, false)
+		require.NoError(t, err)
+		assert.Equal(t, users, reversedUsers)
+		assert.Equal(t, relatedSecrets, reversedSecrets)
 	})
 }
+
+func TestBuildDBUsersAuthTypes(t *testing.T) {
+	dictionary := resources.AtlasNameToKubernetesName()
+	roles := &[]atlasv2.DatabaseUserRole{{RoleName: "readWriteAnyDatabase", DatabaseName: "admin"}}
+
+	for _, tc := range []struct {
+		title          string
+		user           atlasv2.CloudDatabaseUser
+		wantSpec       func(spec *akov2.AtlasDatabaseUserSpec)
+		wantPassword   bool
+		wantNoResource bool
+	}{
+		{
+			title:        "SCRAM user gets a password secret",
+			user:         atlasv2.CloudDatabaseUser{DatabaseName: "admin", Username: "scram", Roles: roles},
+			wantSpec:     func(_ *akov2.AtlasDatabaseUserSpec) {},
+			wantPassword: true,
+		},
+		{
+			title: "SCRAM user with every type set to NONE gets a password secret",
+			user: atlasv2.CloudDatabaseUser{
+				DatabaseName: "admin", Username: "scram", Roles: roles,
+				X509Type: pointer.Get("NONE"), AwsIAMType: pointer.Get("NONE"), LdapAuthType: pointer.Get("NONE"), OidcAuthType: pointer.Get("NONE"),
+			},
+			wantSpec: func(spec *akov2.AtlasDatabaseUserSpec) {
+				spec.X509Type = "NONE"
+				spec.AWSIAMType = "NONE"
+				spec.OIDCAuthType = "NONE"
+			},
+			wantPassword: true,
+		},
+		{
+			title: "AWS IAM role",
+			user: atlasv2.CloudDatabaseUser{
+				DatabaseName: "$external", Username: "arn:aws:iam::123456789012:role/app", Roles: roles,
+				AwsIAMType: pointer.Get("ROLE"),
+			},
+			wantSpec: func(spec *akov2.AtlasDatabaseUserSpec) {
+				spec.AWSIAMType = "ROLE"
+			},
+		},
+		{
+			title: "OIDC workforce group",
+			user: atlasv2.CloudDatabaseUser{
+				DatabaseName: "admin", Username: "idp/group", Roles: roles,
+				OidcAuthType: pointer.Get("IDP_GROUP"),
+			},
+			wantSpec: func(spec *akov2.AtlasDatabaseUserSpec) {
+				spec.OIDCAuthType = "IDP_GROUP"
+			},
+		},
+		{
+			title: "X.509 managed user",
+			user: atlasv2.CloudDatabaseUser{
+				DatabaseName: "$external", Username: "CN=app", Roles: roles,
+				X509Type: pointer.Get("MANAGED"),
+			},
+			wantSpec: func(spec *akov2.AtlasDatabaseUserSpec) {
+				spec.X509Type = "MANAGED"
+			},
+		},
+		{
+			title: "LDAP user is not exported",
+			user: atlasv2.CloudDatabaseUser{
+				DatabaseName: "$external", Username: "ldap-user", Roles: roles,
+				LdapAuthType: pointer.Get("USER"),
+			},
+			wantNoResource: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			mockUserStore := mocks.NewMockDatabaseUserLister(gomock.NewController(t))
+			mockUserStore.EXPECT().DatabaseUsers("0").Return([]atlasv2.CloudDatabaseUser{tc.user}, nil)
+
+			users, relatedSecrets, err := BuildDBUsers(mockUserStore, "0", "my-project", "test", "my-project-credentials", dictionary, resourceVersion, false)
+			require.NoError(t, err)
+
+			if tc.wantNoResource {
+				assert.Empty(t, users)
+				assert.Empty(t, relatedSecrets)
+				return
+			}
+
+			require.Len(t, users, 1)
+			wantSpec := akov2.AtlasDatabaseUserSpec{
+				ProjectDualReference: akov2.ProjectDualReference{
+					ProjectRef: &akov2common.ResourceRefNamespaced{Name: "my-project", Namespace: "test"},
+				},
+				DatabaseName: tc.user.DatabaseName,
+				Username:     tc.user.Username,
+				Labels:       []akov2common.LabelSpec{},
+				Roles:        []akov2.RoleSpec{{RoleName: "readWriteAnyDatabase", DatabaseName: "admin"}},
+				Scopes:       []akov2.ScopeSpec{},
+			}
+			tc.wantSpec(&wantSpec)
+			if tc.wantPassword {
+				require.Len(t, relatedSecrets, 1)
+				wantSpec.PasswordSecret = &akov2common.ResourceRef{Name: relatedSecrets[0].Name}
+			} else {
+				assert.Empty(t, relatedSecrets)
+			}
+			assert.Equal(t, wantSpec, users[0].Spec)
+		})
+	}
+}
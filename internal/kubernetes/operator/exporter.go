@@ -14,6 +14,28 @@
 
 package operator
 
+import (
+	"bytes"
+	"cmp"
+	"slices"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+const (
+	OutputFormatYAML = "yaml"
+	OutputFormatJSON = "json"
+	OutputFormatHelm = "helm"
+)
+
+// OutputFormats are the formats exported resources can be rendered in.
+var OutputFormats = []string{OutputFormatYAML, OutputFormatJSON, OutputFormatHelm}
+
 // Exporter defines the interface for exporting Atlas resources to Kubernetes manifests.
 // This interface allows switching between different exporter implementations based on
 // the CRD version (curated vs generated).
@@ -21,4 +43,128 @@ type Exporter interface {
 	// Run executes the export process and returns the serialized Kubernetes manifests
 	// as a YAML string, or an error if the export fails.
 	Run() (string, error)
+
+	// Export executes the export process and returns the Kubernetes objects
+	// ready to be serialized, or an error if the export fails.
+	Export() ([]runtime.Object, error)
+}
+
+// SecretsStoreExporter replaces the Secrets exported by another exporter with resources of a secret store,
+// such as ExternalSecrets or SealedSecrets, so exports can be committed to git.
+type SecretsStoreExporter struct {
+	exporter  Exporter
+	converter secrets.Converter
+}
+
+func NewSecretsStoreExporter(exporter Exporter, converter secrets.Converter) *SecretsStoreExporter {
+	return &SecretsStoreExporter{
+		exporter:  exporter,
+		converter: converter,
+	}
+}
+
+func (e *SecretsStoreExporter) Run() (string, error) {
+	objects, err := e.Export()
+	if err != nil {
+		return "", err
+	}
+
+	return SerializeObjects(objects)
+}
+
+func (e *SecretsStoreExporter) Export() ([]runtime.Object, error) {
+	objects, err := e.exporter.Export()
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets.ConvertSecrets(objects, e.converter)
+}
+
+// SerializeObjects renders the objects as YAML documents, in the format returned by Exporter.Run.
+func SerializeObjects(objects []runtime.Object) (string, error) {
+	output := bytes.NewBufferString(yamlSeparator)
+
+	serializer := json.NewSerializerWithOptions(
+		json.DefaultMetaFactory,
+		scheme.Scheme,
+		scheme.Scheme,
+		json.SerializerOptions{Yaml: true, Pretty: true},
+	)
+
+	for _, obj := range objects {
+		if err := serializer.Encode(obj, output); err != nil {
+			return "", err
+		}
+		output.WriteString(yamlSeparator)
+	}
+
+	return output.String(), nil
+}
+
+// SerializeList renders the objects as a single JSON document, a Kubernetes List holding them.
+func SerializeList(objects []runtime.Object) (string, error) {
+	serializer := json.NewSerializerWithOptions(
+		json.DefaultMetaFactory,
+		scheme.Scheme,
+		scheme.Scheme,
+		json.SerializerOptions{Pretty: true},
+	)
+
+	list := &metav1.List{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"},
+		Items:    make([]runtime.RawExtension, 0, len(objects)),
+	}
+	for _, obj := range objects {
+		item := &bytes.Buffer{}
+		if err := serializer.Encode(obj, item); err != nil {
+			return "", err
+		}
+		list.Items = append(list.Items, runtime.RawExtension{Raw: bytes.TrimSpace(item.Bytes())})
+	}
+
+	output := &bytes.Buffer{}
+	if err := serializer.Encode(list, output); err != nil {
+		return "", err
+	}
+
+	return output.String(), nil
+}
+
+// Serialize renders the objects in the given output format, YAML documents unless JSON is requested.
+func Serialize(objects []runtime.Object, format string) (string, error) {
+	if format == OutputFormatJSON {
+		return SerializeList(objects)
+	}
+
+	return SerializeObjects(objects)
+}
+
+// sortObjects orders exported objects independently of the order Atlas returns them in:
+// objects are grouped by kind, in the order each kind first appears, and sorted by
+// namespace and name within a kind.
+func sortObjects[T runtime.Object](objects []T) {
+	kindPositions := map[string]int{}
+	for _, obj := range objects {
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		if _, ok := kindPositions[kind]; !ok {
+			kindPositions[kind] = len(kindPositions)
+		}
+	}
+
+	slices.SortStableFunc(objects, func(a, b T) int {
+		return cmp.Or(
+			cmp.Compare(kindPositions[a.GetObjectKind().GroupVersionKind().Kind], kindPositions[b.GetObjectKind().GroupVersionKind().Kind]),
+			cmp.Compare(objectKey(a), objectKey(b)),
+		)
+	})
+}
+
+func objectKey(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+
+	return accessor.GetNamespace() + "/" + accessor.GetName()
 }
@@ -20,6 +20,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ExternalIDAnnotation holds the Atlas ID of a resource exported with auto-generated CRDs.
+const ExternalIDAnnotation = "mongodb.com/external-id"
+
 type Exporter interface {
 	Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error)
 }
@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// nolint:dupl
+// Code generated by tools/exporters. DO NOT EDIT.
+
 package exporter
 
 import (
@@ -38,7 +39,7 @@ func (e *IPAccessListEntryExporter) Export(ctx context.Context, referencedObject
 	for pageNum := 1; ; pageNum++ {
 		resp, _, err := e.client.ProjectIPAccessListApi.ListAccessListEntries(ctx, e.identifiers[0]).PageNum(pageNum).Execute()
 		if err != nil {
-			return nil, fmt.Errorf("failed to list IPAccessListEntrys from Atlas: %w", err)
+			return nil, fmt.Errorf("failed to list IPAccessListEntry resources from Atlas: %w", err)
 		}
 		if resp == nil {
 			return nil, errors.New("no response")
@@ -60,10 +61,7 @@ func (e *IPAccessListEntryExporter) Export(ctx context.Context, referencedObject
 			return nil, fmt.Errorf("failed to translate IPAccessListEntry: %w", err)
 		}
 
-		// Edge case: Atlas returns both IP address and CIDR block when the config is an IP address.
-		if resource.Spec.V20250312 != nil && resource.Spec.V20250312.Entry != nil && resource.Spec.V20250312.Entry.IpAddress != nil {
-			resource.Spec.V20250312.Entry.CidrBlock = nil
-		}
+		normalizeIPAccessListEntry(resource)
 
 		resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("IPAccessListEntry"))
 		var id string
@@ -75,7 +73,7 @@ func (e *IPAccessListEntryExporter) Export(ctx context.Context, referencedObject
 		case resource.Spec.V20250312 != nil && resource.Spec.V20250312.Entry != nil && resource.Spec.V20250312.Entry.AwsSecurityGroup != nil:
 			id = *resource.Spec.V20250312.Entry.AwsSecurityGroup
 		}
-		resource.SetAnnotations(map[string]string{"mongodb.com/external-id": id})
+		resource.SetAnnotations(map[string]string{ExternalIDAnnotation: id})
 
 		resources = append(resources, resource)
 		resources = append(resources, translatedResources...)
@@ -0,0 +1,26 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+)
+
+// normalizeIPAccessListEntry handles an edge case: Atlas returns both IP address and CIDR block when the config is an IP address.
+func normalizeIPAccessListEntry(resource *akov2generated.IPAccessListEntry) {
+	if resource.Spec.V20250312 != nil && resource.Spec.V20250312.Entry != nil && resource.Spec.V20250312.Entry.IpAddress != nil {
+		resource.Spec.V20250312.Entry.CidrBlock = nil
+	}
+}
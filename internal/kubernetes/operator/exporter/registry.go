@@ -20,8 +20,11 @@ import (
 	"go.mongodb.org/atlas-sdk/v20250312018/admin"
 )
 
+//go:generate go run ../../../../tools/exporters
+
 // ResourceConfig defines a resource type that can be exported.
-// To add a new resource, add an entry to the SupportedResources slice.
+// To add a new resource, add its kind to generated/exporters.yaml and run `go generate`, which
+// writes its exporter and its entry in SupportedResources.
 type ResourceConfig struct {
 	// CRDName is the full CRD name (e.g., "groups.atlas.generated.mongodb.com")
 	CRDName string
@@ -29,13 +32,3 @@ type ResourceConfig struct {
 	// Factory creates the exporter for this resource type
 	Factory func(client *admin.APIClient, translator crapi.Translator, identifiers []string) generated.Exporter
 }
-
-// SupportedResources lists all resources that can be exported.
-// To add a new resource, simply add an entry here.
-var SupportedResources = []ResourceConfig{
-	{CRDName: "atlas.generated.mongodb.com_groups", Factory: generated.NewGroupExporter},
-	{CRDName: "atlas.generated.mongodb.com_clusters", Factory: generated.NewClusterExporter},
-	{CRDName: "atlas.generated.mongodb.com_flexclusters", Factory: generated.NewFlexClusterExporter},
-	{CRDName: "atlas.generated.mongodb.com_databaseusers", Factory: generated.NewDatabaseUserExporter},
-	{CRDName: "atlas.generated.mongodb.com_ipaccesslistentries", Factory: generated.NewIPAccessListEntryExporter},
-}
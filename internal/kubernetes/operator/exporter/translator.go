@@ -17,6 +17,7 @@ package exporter
 import (
 	"fmt"
 
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/crapi"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -43,6 +44,26 @@ func NewTranslator(scheme *runtime.Scheme, crd *apiextensionsv1.CustomResourceDe
 	return translator, nil
 }
 
+// NewTranslators creates a translator for every supported resource, keyed by the kind of its CRD.
+func NewTranslators(scheme *runtime.Scheme, provider crds.AtlasOperatorCRDProvider, operatorVersion string) (map[string]crapi.Translator, error) {
+	translators := make(map[string]crapi.Translator, len(SupportedResources))
+	for _, resource := range SupportedResources {
+		crd, err := provider.GetAtlasOperatorResource(resource.CRDName, operatorVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch CRD %s: %w", resource.CRDName, err)
+		}
+
+		translator, err := NewTranslator(scheme, crd, SDKVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create translator for %s: %w", resource.CRDName, err)
+		}
+
+		translators[crd.Spec.Names.Kind] = translator
+	}
+
+	return translators, nil
+}
+
 // extractCRDVersion extracts the CRD version from the CRD spec.
 func extractCRDVersion(crd *apiextensionsv1.CustomResourceDefinition) (string, error) {
 	if len(crd.Spec.Versions) == 0 {
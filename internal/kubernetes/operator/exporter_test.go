@@ -0,0 +1,56 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"encoding/json"
+	"testing"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSerializeList(t *testing.T) {
+	list, err := Serialize([]runtime.Object{
+		NewNamespace("prod"),
+		&akov2.AtlasProject{
+			TypeMeta:   metav1.TypeMeta{Kind: "AtlasProject", APIVersion: "atlas.mongodb.com/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "prod"},
+			Spec:       akov2.AtlasProjectSpec{Name: "My Project"},
+		},
+	}, OutputFormatJSON)
+	require.NoError(t, err)
+
+	decoded := &metav1.List{}
+	require.NoError(t, json.Unmarshal([]byte(list), decoded))
+	assert.Equal(t, "List", decoded.Kind)
+	assert.Equal(t, "v1", decoded.APIVersion)
+	require.Len(t, decoded.Items, 2)
+
+	namespace := &corev1.Namespace{}
+	require.NoError(t, json.Unmarshal(decoded.Items[0].Raw, namespace))
+	assert.Equal(t, "prod", namespace.Name)
+
+	project := &akov2.AtlasProject{}
+	require.NoError(t, json.Unmarshal(decoded.Items[1].Raw, project))
+	assert.Equal(t, "AtlasProject", project.Kind)
+	assert.Equal(t, "My Project", project.Spec.Name)
+}
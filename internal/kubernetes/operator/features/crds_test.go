@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -304,3 +305,15 @@ func Test_CRDCompatibleVersion(t *testing.T) {
 		assert.Equal(t, LatestOperatorMajorVersion, compatibleVersion)
 	})
 }
+
+func TestNewAtlasCRDs_bundled(t *testing.T) {
+	provider := crds.NewBundledAtlasCRDProvider(nil)
+	for version := range versionsToResourcesMap {
+		t.Run(version, func(t *testing.T) {
+			atlasCRDs, err := NewAtlasCRDs(provider, version)
+			require.NoError(t, err)
+			assert.True(t, atlasCRDs.IsResourceSupported(ResourceAtlasProject))
+			assert.True(t, atlasCRDs.FeatureExist(ResourceAtlasProject, "maintenanceWindow"))
+		})
+	}
+}
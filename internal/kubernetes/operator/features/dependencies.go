@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+var resourceKinds = map[string]string{
+	ResourceAtlasProject:                "AtlasProject",
+	ResourceAtlasDeployment:             "AtlasDeployment",
+	ResourceAtlasDatabaseUser:           "AtlasDatabaseUser",
+	ResourceAtlasBackupSchedule:         "AtlasBackupSchedule",
+	ResourceAtlasBackupPolicy:           "AtlasBackupPolicy",
+	ResourceAtlasTeam:                   "AtlasTeam",
+	ResourceAtlasDataFederation:         "AtlasDataFederation",
+	ResourceAtlasFederatedAuth:          "AtlasFederatedAuth",
+	ResourceAtlasStreamInstance:         "AtlasStreamInstance",
+	ResourceAtlasStreamConnection:       "AtlasStreamConnection",
+	ResourceAtlasBackupCompliancePolicy: "AtlasBackupCompliancePolicy",
+	ResourceAtlasPrivateEndpoint:        "AtlasPrivateEndpoint",
+	ResourceAtlasCustomRole:             "AtlasCustomRole",
+	ResourceAtlasIPAccessList:           "AtlasIPAccessList",
+	ResourceAtlasNetworkContainer:       "AtlasNetworkContainer",
+	ResourceAtlasNetworkPeering:         "AtlasNetworkPeering",
+	ResourceAtlasThirdPartyIntegration:  "AtlasThirdPartyIntegration",
+	ResourceAtlasOrgSettings:            "AtlasOrgSettings",
+}
+
+// resourceDependencies lists, for each resource, the resources it may reference
+// and that therefore have to exist in the cluster before it is applied.
+var resourceDependencies = map[string][]string{
+	ResourceAtlasProject:               {ResourceAtlasTeam, ResourceAtlasBackupCompliancePolicy},
+	ResourceAtlasDatabaseUser:          {ResourceAtlasProject},
+	ResourceAtlasBackupSchedule:        {ResourceAtlasBackupPolicy},
+	ResourceAtlasDeployment:            {ResourceAtlasProject, ResourceAtlasBackupSchedule},
+	ResourceAtlasDataFederation:        {ResourceAtlasProject},
+	ResourceAtlasFederatedAuth:         {ResourceAtlasProject},
+	ResourceAtlasStreamInstance:        {ResourceAtlasProject, ResourceAtlasStreamConnection},
+	ResourceAtlasPrivateEndpoint:       {ResourceAtlasProject},
+	ResourceAtlasCustomRole:            {ResourceAtlasProject},
+	ResourceAtlasIPAccessList:          {ResourceAtlasProject},
+	ResourceAtlasNetworkContainer:      {ResourceAtlasProject},
+	ResourceAtlasNetworkPeering:        {ResourceAtlasProject, ResourceAtlasNetworkContainer},
+	ResourceAtlasThirdPartyIntegration: {ResourceAtlasProject},
+}
+
+// GetResourcesInDependencyOrder returns the resources supported by the given version
+// ordered so that every resource comes after the resources it depends on.
+// Resources without dependencies between them keep the order of GetResourcesForVersion.
+func GetResourcesInDependencyOrder(version string) ([]string, bool) {
+	resources, ok := GetResourcesForVersion(version)
+	if !ok {
+		return nil, false
+	}
+
+	supported := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		supported[resource] = struct{}{}
+	}
+
+	result := make([]string, 0, len(resources))
+	visited := make(map[string]bool, len(resources))
+
+	var visit func(resource string)
+	visit = func(resource string) {
+		if _, ok := supported[resource]; !ok || visited[resource] {
+			return
+		}
+		visited[resource] = true
+
+		for _, dependency := range resourceDependencies[resource] {
+			visit(dependency)
+		}
+
+		result = append(result, resource)
+	}
+
+	for _, resource := range resources {
+		visit(resource)
+	}
+
+	return result, true
+}
+
+// ResourceForKind returns the resource name, as used by versionsToResourcesMap,
+// that matches the given Kubernetes kind.
+func ResourceForKind(kind string) (string, bool) {
+	for resource, resourceKind := range resourceKinds {
+		if resourceKind == kind {
+			return resource, true
+		}
+	}
+
+	return "", false
+}
+
+// KindForResource returns the Kubernetes kind of the given resource name.
+func KindForResource(resource string) (string, bool) {
+	kind, ok := resourceKinds[resource]
+
+	return kind, ok
+}
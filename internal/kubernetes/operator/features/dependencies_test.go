@@ -0,0 +1,65 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResourcesInDependencyOrder(t *testing.T) {
+	for _, version := range SupportedVersions() {
+		t.Run(version, func(t *testing.T) {
+			resources, _ := GetResourcesForVersion(version)
+			ordered, ok := GetResourcesInDependencyOrder(version)
+			require.True(t, ok)
+			assert.ElementsMatch(t, resources, ordered)
+
+			positions := map[string]int{}
+			for i, resource := range ordered {
+				positions[resource] = i
+			}
+			for resource, dependencies := range resourceDependencies {
+				for _, dependency := range dependencies {
+					assert.Less(t, positions[dependency], positions[resource], "%s must be applied before %s", dependency, resource)
+				}
+			}
+		})
+	}
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, ok := GetResourcesInDependencyOrder("1.0.0")
+		assert.False(t, ok)
+	})
+}
+
+func TestResourceKinds(t *testing.T) {
+	for _, version := range SupportedVersions() {
+		resources, _ := GetResourcesForVersion(version)
+		for _, resource := range resources {
+			kind, ok := KindForResource(resource)
+			require.True(t, ok, "resource %s has no kind", resource)
+
+			got, ok := ResourceForKind(kind)
+			require.True(t, ok)
+			assert.Equal(t, resource, got)
+		}
+	}
+
+	_, ok := ResourceForKind("Secret")
+	assert.False(t, ok)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Kinds returns the sorted kinds of every custom resource the exporter supports.
+func Kinds() []string {
+	result := make([]string, 0, len(resourceKinds))
+	for _, kind := range resourceKinds {
+		result = append(result, kind)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// ResourceSelection restricts an export to some resources. A resource is selected when
+// it is included, or nothing is included, and it is not excluded.
+// A nil selection selects every resource.
+type ResourceSelection struct {
+	included map[string]struct{}
+	excluded map[string]struct{}
+}
+
+// NewResourceSelection builds a selection from lists of custom resource kinds.
+func NewResourceSelection(include, exclude []string) (*ResourceSelection, error) {
+	included, err := resourcesForKinds(include)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := resourcesForKinds(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceSelection{
+		included: included,
+		excluded: excluded,
+	}, nil
+}
+
+// IsSelected reports whether the resource, given by its CRD resource name, is part of the selection.
+func (s *ResourceSelection) IsSelected(resourceName string) bool {
+	if s == nil {
+		return true
+	}
+
+	if len(s.included) > 0 {
+		if _, ok := s.included[resourceName]; !ok {
+			return false
+		}
+	}
+
+	_, excluded := s.excluded[resourceName]
+	return !excluded
+}
+
+func resourcesForKinds(kinds []string) (map[string]struct{}, error) {
+	result := make(map[string]struct{}, len(kinds))
+	for _, kind := range kinds {
+		resourceName, ok := ResourceForKind(kind)
+		if !ok {
+			return nil, fmt.Errorf("resource kind %q is not supported. Supported kinds: %v", kind, Kinds())
+		}
+		result[resourceName] = struct{}{}
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSelection(t *testing.T) {
+	t.Run("nil selection selects every resource", func(t *testing.T) {
+		var selection *ResourceSelection
+		assert.True(t, selection.IsSelected(ResourceAtlasOrgSettings))
+	})
+
+	t.Run("only included kinds are selected", func(t *testing.T) {
+		selection, err := NewResourceSelection([]string{"AtlasDeployment", "AtlasDatabaseUser"}, nil)
+		require.NoError(t, err)
+		assert.True(t, selection.IsSelected(ResourceAtlasDeployment))
+		assert.True(t, selection.IsSelected(ResourceAtlasDatabaseUser))
+		assert.False(t, selection.IsSelected(ResourceAtlasOrgSettings))
+	})
+
+	t.Run("excluded kinds are not selected", func(t *testing.T) {
+		selection, err := NewResourceSelection(nil, []string{"AtlasOrgSettings", "AtlasTeam"})
+		require.NoError(t, err)
+		assert.True(t, selection.IsSelected(ResourceAtlasDeployment))
+		assert.False(t, selection.IsSelected(ResourceAtlasOrgSettings))
+		assert.False(t, selection.IsSelected(ResourceAtlasTeam))
+	})
+
+	t.Run("exclusion wins over inclusion", func(t *testing.T) {
+		selection, err := NewResourceSelection([]string{"AtlasDeployment", "AtlasBackupSchedule"}, []string{"AtlasBackupSchedule"})
+		require.NoError(t, err)
+		assert.True(t, selection.IsSelected(ResourceAtlasDeployment))
+		assert.False(t, selection.IsSelected(ResourceAtlasBackupSchedule))
+	})
+
+	t.Run("unknown kinds are rejected", func(t *testing.T) {
+		_, err := NewResourceSelection([]string{"AtlasCluster"}, nil)
+		require.ErrorContains(t, err, `resource kind "AtlasCluster" is not supported`)
+	})
+}
@@ -0,0 +1,303 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	helmChartFile    = "Chart.yaml"
+	helmValuesFile   = "values.yaml"
+	helmTemplatesDir = "templates"
+	helmAPIVersion   = "v2"
+	helmChartVersion = "0.1.0"
+	helmValuesHeader = "# Values of the exported Atlas resources, override them to promote the chart across environments.\n"
+)
+
+type helmChart struct {
+	APIVersion  string `json:"apiVersion"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion,omitempty"`
+}
+
+type helmValues struct {
+	Namespace string                       `json:"namespace"`
+	Secrets   map[string]map[string]string `json:"secrets,omitempty"`
+	Clusters  map[string]helmClusterValues `json:"clusters,omitempty"`
+
+	// templates are the template actions replacing the placeholders set in objects, once serialized.
+	// Placeholders keep the serializer from quoting and wrapping the actions.
+	templates []string
+}
+
+type helmClusterValues struct {
+	InstanceSize string `json:"instanceSize"`
+}
+
+// HelmChartWriter writes exported objects to a directory as a Helm chart skeleton. The namespace, the data
+// of secrets and the instance size of each cluster are moved to values.yaml, so one export can be installed
+// in several environments with different values.
+type HelmChartWriter struct {
+	fs         afero.Fs
+	dir        string
+	appVersion string
+}
+
+func NewHelmChartWriter(fs afero.Fs, dir string) *HelmChartWriter {
+	return &HelmChartWriter{
+		fs:  fs,
+		dir: dir,
+	}
+}
+
+// WithAppVersion sets the version of the operator the chart resources are generated for.
+func (w *HelmChartWriter) WithAppVersion(version string) *HelmChartWriter {
+	w.appVersion = version
+	return w
+}
+
+// Write renders the chart into the output directory. Like ManifestWriter, files are rewritten only when their
+// content changed and templates of objects which are no longer exported are removed.
+func (w *HelmChartWriter) Write(objects []runtime.Object) ([]ManifestResult, error) {
+	values := &helmValues{
+		Secrets:  map[string]map[string]string{},
+		Clusters: map[string]helmClusterValues{},
+	}
+
+	paths := make([]string, 0, len(objects))
+	contents := make([][]byte, 0, len(objects))
+	for _, obj := range objects {
+		path, err := manifestPath(obj, false)
+		if err != nil {
+			return nil, err
+		}
+		path = helmTemplatesDir + "/" + strings.Replace(path, "/", "-", 1)
+		if slices.Contains(paths, path) {
+			return nil, fmt.Errorf("more than one object would be written to %s", path)
+		}
+
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s: %w", path, err)
+		}
+		if err = values.template(object); err != nil {
+			return nil, fmt.Errorf("failed to template %s: %w", path, err)
+		}
+
+		content, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize %s: %w", path, err)
+		}
+		paths = append(paths, path)
+		contents = append(contents, content)
+	}
+
+	replacer := values.replacer()
+	for i := range contents {
+		contents[i] = []byte(replacer.Replace(string(contents[i])))
+	}
+
+	chart, err := yaml.Marshal(helmChart{
+		APIVersion:  helmAPIVersion,
+		Name:        strings.ToLower(filepath.Base(filepath.Clean(w.dir))),
+		Description: "Atlas resources managed by Atlas Kubernetes Operator",
+		Type:        "application",
+		Version:     helmChartVersion,
+		AppVersion:  w.appVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize %s: %w", helmChartFile, err)
+	}
+
+	valuesContent, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize %s: %w", helmValuesFile, err)
+	}
+
+	files := &ManifestWriter{fs: w.fs, dir: w.dir}
+	paths = append([]string{helmChartFile, helmValuesFile}, paths...)
+	contents = append([][]byte{chart, append([]byte(helmValuesHeader), valuesContent...)}, contents...)
+
+	results := make([]ManifestResult, 0, len(paths))
+	for i, path := range paths {
+		action, err := files.writeFile(path, contents[i])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, ManifestResult{Path: path, Action: action})
+	}
+
+	removed, err := w.removeStaleTemplates(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(results, removed...), nil
+}
+
+// removeStaleTemplates removes the templates written by a previous export which are no longer exported.
+func (w *HelmChartWriter) removeStaleTemplates(paths []string) ([]ManifestResult, error) {
+	entries, err := afero.ReadDir(w.fs, filepath.Join(w.dir, helmTemplatesDir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", helmTemplatesDir, err)
+	}
+
+	var results []ManifestResult
+	for _, entry := range entries {
+		path := helmTemplatesDir + "/" + entry.Name()
+		if entry.IsDir() || filepath.Ext(path) != ".yaml" || slices.Contains(paths, path) {
+			continue
+		}
+		if err = w.fs.Remove(filepath.Join(w.dir, filepath.FromSlash(path))); err != nil {
+			return nil, fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		results = append(results, ManifestResult{Path: path, Action: ManifestActionRemoved})
+	}
+
+	return results, nil
+}
+
+// template replaces the environment specific fields of an object with references to the chart values,
+// and records their exported value as the default one.
+func (v *helmValues) template(object map[string]any) error {
+	metadata, _ := object["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+
+	if namespace, ok := metadata["namespace"].(string); ok && namespace != "" {
+		if v.Namespace == "" {
+			v.Namespace = namespace
+		}
+		metadata["namespace"] = v.placeholder("{{ .Values.namespace }}")
+	}
+
+	if object["kind"] == "Secret" {
+		return v.templateSecret(name, object)
+	}
+
+	if spec, ok := object["spec"].(map[string]any); ok {
+		v.templateInstanceSizes(name, spec)
+	}
+
+	return nil
+}
+
+// templateSecret moves the data of a secret to the values, in plain text.
+func (v *helmValues) templateSecret(name string, object map[string]any) error {
+	secretValues := map[string]string{}
+	data, _ := object["data"].(map[string]any)
+	for key, value := range data {
+		encoded, _ := value.(string)
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to decode key %s of secret %s: %w", key, name, err)
+		}
+		secretValues[key] = string(decoded)
+	}
+
+	stringData, _ := object["stringData"].(map[string]any)
+	for key, value := range stringData {
+		secretValues[key], _ = value.(string)
+	}
+	delete(object, "stringData")
+
+	if len(secretValues) == 0 {
+		return nil
+	}
+
+	templated := make(map[string]any, len(secretValues))
+	for key := range secretValues {
+		templated[key] = v.placeholder(fmt.Sprintf("{{ index .Values.secrets %q %q | b64enc }}", name, key))
+	}
+	object["data"] = templated
+	v.Secrets[name] = secretValues
+
+	return nil
+}
+
+// templateInstanceSizes replaces the instance sizes of a cluster which match the size of its electable nodes,
+// or else its first instance size, with the instance size value of the cluster.
+func (v *helmValues) templateInstanceSizes(name string, spec map[string]any) {
+	var fields []map[string]any
+	instanceSize := ""
+	electable := false
+	walkInstanceSizes(spec, "", func(field map[string]any, parent string) {
+		fields = append(fields, field)
+		if instanceSize == "" || (parent == "electableSpecs" && !electable) {
+			instanceSize, _ = field["instanceSize"].(string)
+			electable = parent == "electableSpecs"
+		}
+	})
+	if instanceSize == "" {
+		return
+	}
+
+	v.Clusters[name] = helmClusterValues{InstanceSize: instanceSize}
+	templated := v.placeholder(fmt.Sprintf("{{ index .Values.clusters %q \"instanceSize\" }}", name))
+	for _, field := range fields {
+		if field["instanceSize"] == instanceSize {
+			field["instanceSize"] = templated
+		}
+	}
+}
+
+func (v *helmValues) placeholder(template string) string {
+	v.templates = append(v.templates, template)
+	return fmt.Sprintf("__helm_template_%d__", len(v.templates)-1)
+}
+
+func (v *helmValues) replacer() *strings.Replacer {
+	replacements := make([]string, 0, 2*len(v.templates))
+	for i, template := range v.templates {
+		replacements = append(replacements, fmt.Sprintf("__helm_template_%d__", i), template)
+	}
+
+	return strings.NewReplacer(replacements...)
+}
+
+// walkInstanceSizes visits, in a stable order, every object of a spec holding an instance size,
+// along with the name of the field holding that object.
+func walkInstanceSizes(value any, parent string, visit func(field map[string]any, parent string)) {
+	switch field := value.(type) {
+	case map[string]any:
+		if instanceSize, ok := field["instanceSize"].(string); ok && instanceSize != "" {
+			visit(field, parent)
+		}
+		for _, key := range slices.Sorted(maps.Keys(field)) {
+			walkInstanceSizes(field[key], key, visit)
+		}
+	case []any:
+		for _, item := range field {
+			walkInstanceSizes(item, parent, visit)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"strings"
+	"testing"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHelmChartWriter_Write(t *testing.T) {
+	deployment := &akov2.AtlasDeployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "AtlasDeployment", APIVersion: "atlas.mongodb.com/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project-cluster0", Namespace: "prod"},
+		Spec: akov2.AtlasDeploymentSpec{
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				Name: "cluster0",
+				ReplicationSpecs: []*akov2.AdvancedReplicationSpec{{
+					RegionConfigs: []*akov2.AdvancedRegionConfig{{
+						AnalyticsSpecs: &akov2.Specs{InstanceSize: "M40"},
+						ElectableSpecs: &akov2.Specs{InstanceSize: "M30"},
+						ReadOnlySpecs:  &akov2.Specs{InstanceSize: "M30"},
+					}},
+				}},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project-credentials", Namespace: "prod"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t")},
+	}
+
+	fs := afero.NewMemMapFs()
+	writer := NewHelmChartWriter(fs, "charts/Atlas").WithAppVersion("2.15.0")
+
+	t.Run("should write the chart, its values and one template per object", func(t *testing.T) {
+		results, err := writer.Write([]runtime.Object{deployment, secret})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "Chart.yaml", Action: ManifestActionWritten},
+			{Path: "values.yaml", Action: ManifestActionWritten},
+			{Path: "templates/atlasdeployment-my-project-cluster0.yaml", Action: ManifestActionWritten},
+			{Path: "templates/secret-my-project-credentials.yaml", Action: ManifestActionWritten},
+		}, results)
+
+		chart, err := afero.ReadFile(fs, "charts/Atlas/Chart.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, `apiVersion: v2
+appVersion: 2.15.0
+description: Atlas resources managed by Atlas Kubernetes Operator
+name: atlas
+type: application
+version: 0.1.0
+`, string(chart))
+
+		values, err := afero.ReadFile(fs, "charts/Atlas/values.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, helmValuesHeader+`clusters:
+  my-project-cluster0:
+    instanceSize: M30
+namespace: prod
+secrets:
+  my-project-credentials:
+    password: s3cr3t
+`, string(values))
+
+		template, err := afero.ReadFile(fs, "charts/Atlas/templates/atlasdeployment-my-project-cluster0.yaml")
+		require.NoError(t, err)
+		assert.Contains(t, string(template), `namespace: {{ .Values.namespace }}`)
+		assert.Contains(t, string(template), "instanceSize: M40")
+		assert.Equal(t, 2, strings.Count(string(template), `instanceSize: {{ index .Values.clusters "my-project-cluster0" "instanceSize" }}`))
+
+		template, err = afero.ReadFile(fs, "charts/Atlas/templates/secret-my-project-credentials.yaml")
+		require.NoError(t, err)
+		assert.Contains(t, string(template), `password: {{ index .Values.secrets "my-project-credentials" "password" | b64enc }}`)
+		assert.NotContains(t, string(template), "s3cr3t")
+	})
+
+	t.Run("should only rewrite changed files and remove templates no longer exported", func(t *testing.T) {
+		results, err := writer.Write([]runtime.Object{deployment})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "Chart.yaml", Action: ManifestActionUnchanged},
+			{Path: "values.yaml", Action: ManifestActionWritten},
+			{Path: "templates/atlasdeployment-my-project-cluster0.yaml", Action: ManifestActionUnchanged},
+			{Path: "templates/secret-my-project-credentials.yaml", Action: ManifestActionRemoved},
+		}, results)
+	})
+}
@@ -36,6 +36,9 @@ import (
 const (
 	roleOrgGroupCreator = "ORG_GROUP_CREATOR"
 	roleProjectOwner    = "GROUP_OWNER"
+	// serviceAccountSecretValidityInHours is how long the secret of the operator service account
+	// stays valid, unless the organization settings allow less.
+	serviceAccountSecretValidityInHours = 365 * 24
 )
 
 type Install struct {
@@ -55,6 +58,7 @@ type Install struct {
 	atlasGov                     bool
 	configOnly                   bool
 	ipAccessList                 string
+	serviceAccount               bool
 }
 
 func (i *Install) WithConfigOnly(configOnly bool) *Install {
@@ -105,14 +109,19 @@ func (i *Install) WithAtlasGov(flag bool) *Install {
 	return i
 }
 
+func (i *Install) WithServiceAccount(flag bool) *Install {
+	i.serviceAccount = flag
+
+	return i
+}
+
 func (i *Install) Run(ctx context.Context, orgID string) error {
-	keys, err := i.generateKeys(orgID)
+	credentials, credentialsID, err := i.generateCredentials(orgID)
 	if err != nil {
 		return err
 	}
 
-	err = i.addAPIKeyIPAccessList(orgID, keys.GetId())
-	if err != nil {
+	if err = i.installResources.InstallNamespace(ctx, i.namespace); err != nil {
 		return err
 	}
 
@@ -136,14 +145,13 @@ func (i *Install) Run(ctx context.Context, orgID string) error {
 		ctx,
 		i.namespace,
 		orgID,
-		keys.GetPublicKey(),
-		keys.GetPrivateKey(),
+		*credentials,
 		i.projectName); err != nil {
 		return err
 	}
 
 	if i.importResources {
-		if err = i.importAtlasResources(orgID, keys.GetId()); err != nil {
+		if err = i.importAtlasResources(orgID, credentialsID); err != nil {
 			return err
 		}
 
@@ -176,6 +184,37 @@ func (i *Install) ensureProject(orgID, projectName string) (*admin.Group, error)
 	return project, nil
 }
 
+// generateCredentials creates the Atlas credentials of the operator, restricted to the IP access list.
+// It returns them along with the ID of the API key, or the client ID of the service account.
+func (i *Install) generateCredentials(orgID string) (*Credentials, string, error) {
+	if i.serviceAccount {
+		serviceAccount, err := i.generateServiceAccount(orgID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if err = i.addServiceAccountIPAccessList(orgID, serviceAccount.ClientID); err != nil {
+			return nil, "", err
+		}
+
+		return serviceAccount, serviceAccount.ClientID, nil
+	}
+
+	keys, err := i.generateKeys(orgID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err = i.addAPIKeyIPAccessList(orgID, keys.GetId()); err != nil {
+		return nil, "", err
+	}
+
+	return &Credentials{
+		PublicKey:  keys.GetPublicKey(),
+		PrivateKey: keys.GetPrivateKey(),
+	}, keys.GetId(), nil
+}
+
 func (i *Install) generateKeys(orgID string) (*admin.ApiKeyUserDetails, error) {
 	if i.projectName == "" {
 		input := &admin.CreateAtlasOrganizationApiKey{
@@ -211,6 +250,78 @@ func (i *Install) generateKeys(orgID string) (*admin.ApiKeyUserDetails, error) {
 	return keys, nil
 }
 
+func (i *Install) generateServiceAccount(orgID string) (*Credentials, error) {
+	validity, err := i.serviceAccountSecretValidity(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientID string
+	var secrets []admin.ServiceAccountSecret
+
+	if i.projectName == "" {
+		input := &admin.OrgServiceAccountRequest{
+			Name:                    credentialsGlobalName,
+			Description:             credentialsGlobalName,
+			SecretExpiresAfterHours: validity,
+			Roles: []string{
+				roleOrgGroupCreator,
+			},
+		}
+		serviceAccount, err := i.atlasStore.CreateOrganizationServiceAccount(orgID, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate org service account: %w", err)
+		}
+
+		clientID, secrets = serviceAccount.GetClientId(), serviceAccount.GetSecrets()
+	} else {
+		project, err := i.ensureProject(orgID, i.projectName)
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf(credentialsProjectScopedName, resources.NormalizeAtlasName(i.projectName, resources.AtlasNameToKubernetesName()))
+		input := &admin.GroupServiceAccountRequest{
+			Name:                    name,
+			Description:             name,
+			SecretExpiresAfterHours: validity,
+			Roles: []string{
+				roleProjectOwner,
+			},
+		}
+		serviceAccount, err := i.atlasStore.CreateProjectServiceAccount(project.GetId(), input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate project service account: %w", err)
+		}
+
+		clientID, secrets = serviceAccount.GetClientId(), serviceAccount.GetSecrets()
+	}
+
+	if len(secrets) == 0 || secrets[0].GetSecret() == "" {
+		return nil, fmt.Errorf("no secret returned for service account %s", clientID)
+	}
+
+	return &Credentials{
+		ClientID:     clientID,
+		ClientSecret: secrets[0].GetSecret(),
+	}, nil
+}
+
+// serviceAccountSecretValidity caps the validity of the service account secret to the maximum
+// allowed by the organization.
+func (i *Install) serviceAccountSecretValidity(orgID string) (int, error) {
+	settings, err := i.atlasStore.GetOrgSettings(orgID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve organization settings: %w", err)
+	}
+
+	if maxValidity := settings.GetMaxServiceAccountSecretValidityInHours(); maxValidity > 0 && maxValidity < serviceAccountSecretValidityInHours {
+		return maxValidity, nil
+	}
+
+	return serviceAccountSecretValidityInHours, nil
+}
+
 func (i *Install) addAPIKeyIPAccessList(orgID, apiKeyID string) error {
 	list := strings.Split(i.ipAccessList, ",")
 	entries := make([]admin.UserAccessListRequest, 0, len(list))
@@ -239,7 +350,35 @@ func (i *Install) addAPIKeyIPAccessList(orgID, apiKeyID string) error {
 	return nil
 }
 
-func (i *Install) importAtlasResources(orgID, apiKeyID string) error {
+func (i *Install) addServiceAccountIPAccessList(orgID, clientID string) error {
+	list := strings.Split(i.ipAccessList, ",")
+	entries := make([]admin.ServiceAccountIPAccessListEntry, 0, len(list))
+
+	for _, entry := range list {
+		if strings.Contains(entry, "/") {
+			entries = append(entries, admin.ServiceAccountIPAccessListEntry{
+				CidrBlock: &entry,
+			})
+		} else {
+			entries = append(entries, admin.ServiceAccountIPAccessListEntry{
+				IpAddress: &entry,
+			})
+		}
+	}
+
+	err := i.atlasStore.AddServiceAccountIPAccessList(
+		orgID,
+		clientID,
+		&entries,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add IP access list to service account: %w", err)
+	}
+
+	return nil
+}
+
+func (i *Install) importAtlasResources(orgID, credentialsID string) error {
 	projectsIDs := make([]string, 0)
 
 	if i.projectName != "" {
@@ -266,15 +405,8 @@ func (i *Install) importAtlasResources(orgID, apiKeyID string) error {
 	}
 
 	for _, projectID := range projectsIDs {
-		err = i.atlasStore.AssignProjectAPIKey(
-			projectID,
-			apiKeyID,
-			&admin.UpdateAtlasProjectApiKey{
-				Roles: &[]string{roleProjectOwner},
-			},
-		)
-		if err != nil {
-			return fmt.Errorf("failed to assign api key to project %s: %w", projectID, err)
+		if err = i.assignProject(projectID, credentialsID); err != nil {
+			return err
 		}
 
 		exporter := NewConfigExporter(i.atlasStore, i.credStore, projectID, orgID).
@@ -283,7 +415,7 @@ func (i *Install) importAtlasResources(orgID, apiKeyID string) error {
 			WithFeatureValidator(i.featureValidator).
 			WithSecretsData(false)
 
-		err = NewConfigApply(
+		_, err = NewConfigApply(
 			NewConfigApplyParams{
 				OrgID:     orgID,
 				ProjectID: projectID,
@@ -302,6 +434,36 @@ func (i *Install) importAtlasResources(orgID, apiKeyID string) error {
 	return nil
 }
 
+func (i *Install) assignProject(projectID, credentialsID string) error {
+	if i.serviceAccount {
+		err := i.atlasStore.AssignProjectServiceAccount(
+			projectID,
+			credentialsID,
+			&admin.GroupServiceAccountRoleAssignment{
+				Roles: []string{roleProjectOwner},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to assign service account to project %s: %w", projectID, err)
+		}
+
+		return nil
+	}
+
+	err := i.atlasStore.AssignProjectAPIKey(
+		projectID,
+		credentialsID,
+		&admin.UpdateAtlasProjectApiKey{
+			Roles: &[]string{roleProjectOwner},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to assign api key to project %s: %w", projectID, err)
+	}
+
+	return nil
+}
+
 func (i *Install) ensureCredentialsAssignment(ctx context.Context) error {
 	projects := &akov2.AtlasProjectList{}
 	err := i.kubectl.List(ctx, projects, client.InNamespace(i.namespace))
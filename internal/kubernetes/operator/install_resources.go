@@ -18,20 +18,26 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/version"
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -51,12 +57,24 @@ type InstallConfig struct {
 	SubResourceDeletionProtectionEnabled bool
 	AtlasGov                             bool
 	ConfigOnly                           bool
+	// AtlasDomain the operator connects to, it defaults to the commercial or the Atlas for Government domain when empty.
+	AtlasDomain string
+}
+
+// Credentials the operator uses to connect to Atlas: either a programmatic API key or the
+// client credentials of a service account.
+type Credentials struct {
+	PublicKey    string
+	PrivateKey   string
+	ClientID     string
+	ClientSecret string
 }
 
 type Installer interface {
+	InstallNamespace(ctx context.Context, namespace string) error
 	InstallCRDs(ctx context.Context, v string, namespaced bool) error
 	InstallConfiguration(ctx context.Context, installConfig *InstallConfig) error
-	InstallCredentials(ctx context.Context, namespace, orgID, publicKey, privateKey string, projectName string) error
+	InstallCredentials(ctx context.Context, namespace, orgID string, credentials Credentials, projectName string) error
 }
 
 type InstallResources struct {
@@ -65,6 +83,36 @@ type InstallResources struct {
 	objConverter          runtime.UnstructuredConverter
 	deletionProtection    bool
 	subDeletionProtection bool
+	upgrade               bool
+	render                bool
+	manifests             []runtime.Object
+}
+
+// WithUpgrade makes the installer update the resources that already exist instead of failing.
+func (ir *InstallResources) WithUpgrade(upgrade bool) *InstallResources {
+	ir.upgrade = upgrade
+
+	return ir
+}
+
+// InstallNamespace creates the namespace the operator is installed in, unless it exists already.
+func (ir *InstallResources) InstallNamespace(ctx context.Context, namespace string) error {
+	obj := NewNamespace(namespace)
+	if ir.render {
+		ir.manifests = append(ir.manifests, obj)
+		return nil
+	}
+
+	// users only allowed to install into an existing namespace may not read it
+	if err := ir.kubeCtl.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.Namespace{}); !apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err := ir.kubeCtl.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to add Namespace into cluster: %w", err)
+	}
+
+	return nil
 }
 
 func (ir *InstallResources) InstallCRDs(ctx context.Context, v string, namespaced bool) error {
@@ -96,7 +144,7 @@ func (ir *InstallResources) InstallCRDs(ctx context.Context, v string, namespace
 			return fmt.Errorf("failed to convert CRD object: %w", err)
 		}
 
-		err = ir.kubeCtl.Create(ctx, crd)
+		err = ir.save(ctx, crd)
 		if err != nil {
 			return fmt.Errorf("failed to add CRD into cluster: %w", err)
 		}
@@ -152,14 +200,31 @@ func (ir *InstallResources) handleKind(ctx context.Context, installConfig *Insta
 	return nil
 }
 
-func (ir *InstallResources) InstallCredentials(ctx context.Context, namespace, orgID, publicKey, privateKey string, projectName string) error {
+func (ir *InstallResources) InstallCredentials(ctx context.Context, namespace, orgID string, credentials Credentials, projectName string) error {
 	name := credentialsGlobalName
 
 	if projectName != "" {
 		name = fmt.Sprintf(credentialsProjectScopedName, resources.NormalizeAtlasName(projectName, resources.AtlasNameToKubernetesName()))
 	}
 
+	data := map[string]string{
+		secrets.CredOrgID:         orgID,
+		secrets.CredPublicAPIKey:  credentials.PublicKey,
+		secrets.CredPrivateAPIKey: credentials.PrivateKey,
+	}
+	if credentials.ClientID != "" {
+		data = map[string]string{
+			secrets.CredOrgID:        orgID,
+			secrets.CredClientID:     credentials.ClientID,
+			secrets.CredClientSecret: credentials.ClientSecret,
+		}
+	}
+
 	obj := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
@@ -167,14 +232,10 @@ func (ir *InstallResources) InstallCredentials(ctx context.Context, namespace, o
 				"atlas.mongodb.com/type": "credentials",
 			},
 		},
-		StringData: map[string]string{
-			"orgId":         orgID,
-			"publicApiKey":  publicKey,
-			"privateApiKey": privateKey,
-		},
+		StringData: data,
 	}
 
-	err := ir.kubeCtl.Create(ctx, obj)
+	err := ir.save(ctx, obj)
 	if err != nil {
 		return fmt.Errorf("failed to add Secret into cluster: %w", err)
 	}
@@ -191,7 +252,7 @@ func (ir *InstallResources) addServiceAccount(ctx context.Context, config map[st
 
 	obj.SetNamespace(namespace)
 
-	err = ir.kubeCtl.Create(ctx, obj)
+	err = ir.save(ctx, obj)
 	if err != nil {
 		return fmt.Errorf("failed to add ServiceAccount into cluster: %w", err)
 	}
@@ -208,7 +269,7 @@ func (ir *InstallResources) addRoles(ctx context.Context, config map[string]any,
 		}
 	}
 
-	for watchNamespace := range namespaces {
+	for _, watchNamespace := range slices.Sorted(maps.Keys(namespaces)) {
 		obj := &rbacv1.Role{}
 		err := ir.objConverter.FromUnstructured(config, obj)
 		if err != nil {
@@ -217,7 +278,7 @@ func (ir *InstallResources) addRoles(ctx context.Context, config map[string]any,
 
 		obj.SetNamespace(watchNamespace)
 
-		err = ir.kubeCtl.Create(ctx, obj)
+		err = ir.save(ctx, obj)
 		if err != nil {
 			return fmt.Errorf("failed to add Role into cluster: %w", err)
 		}
@@ -235,7 +296,7 @@ func (ir *InstallResources) addClusterRole(ctx context.Context, config map[strin
 
 	obj.SetNamespace(namespace)
 
-	err = ir.kubeCtl.Create(ctx, obj)
+	err = ir.save(ctx, obj)
 	if err != nil {
 		return fmt.Errorf("failed to add ClusterRole into cluster: %w", err)
 	}
@@ -252,7 +313,7 @@ func (ir *InstallResources) addRoleBindings(ctx context.Context, config map[stri
 		}
 	}
 
-	for watchNamespace := range namespaces {
+	for _, watchNamespace := range slices.Sorted(maps.Keys(namespaces)) {
 		obj := &rbacv1.RoleBinding{}
 		err := ir.objConverter.FromUnstructured(config, obj)
 		if err != nil {
@@ -262,7 +323,7 @@ func (ir *InstallResources) addRoleBindings(ctx context.Context, config map[stri
 		obj.SetNamespace(watchNamespace)
 		obj.Subjects[0].Namespace = namespace
 
-		err = ir.kubeCtl.Create(ctx, obj)
+		err = ir.save(ctx, obj)
 		if err != nil {
 			return fmt.Errorf("failed to add RoleBinding into cluster: %w", err)
 		}
@@ -281,7 +342,7 @@ func (ir *InstallResources) addClusterRoleBinding(ctx context.Context, config ma
 	obj.SetNamespace(namespace)
 	obj.Subjects[0].Namespace = namespace
 
-	err = ir.kubeCtl.Create(ctx, obj)
+	err = ir.save(ctx, obj)
 	if err != nil {
 		return fmt.Errorf("failed to add ClusterRoleBinding into cluster: %w", err)
 	}
@@ -299,7 +360,10 @@ func (ir *InstallResources) addDeployment(ctx context.Context, config map[string
 	obj.SetNamespace(installConfig.Namespace)
 
 	if len(obj.Spec.Template.Spec.Containers) > 0 {
-		atlasDomain := os.Getenv("MCLI_OPS_MANAGER_URL")
+		atlasDomain := installConfig.AtlasDomain
+		if atlasDomain == "" {
+			atlasDomain = os.Getenv("MCLI_OPS_MANAGER_URL")
+		}
 		if atlasDomain == "" {
 			atlasDomain = "https://cloud.mongodb.com/"
 			if installConfig.AtlasGov {
@@ -334,7 +398,7 @@ func (ir *InstallResources) addDeployment(ctx context.Context, config map[string
 		}
 	}
 
-	err = ir.kubeCtl.Create(ctx, obj)
+	err = ir.save(ctx, obj)
 	if err != nil {
 		return fmt.Errorf("failed to add Deployment into cluster: %w", err)
 	}
@@ -342,6 +406,43 @@ func (ir *InstallResources) addDeployment(ctx context.Context, config map[string
 	return nil
 }
 
+// WithRenderManifests makes the installer collect the resources instead of adding them into the cluster.
+func (ir *InstallResources) WithRenderManifests(render bool) *InstallResources {
+	ir.render = render
+
+	return ir
+}
+
+// Manifests returns the resources collected when rendering manifests, in installation order.
+func (ir *InstallResources) Manifests() []runtime.Object {
+	return ir.manifests
+}
+
+// save creates the object in the cluster. When upgrading, an existing object is server-side applied, so the
+// fields set by others, e.g. labels, annotations or the replicas of a scaled deployment, are left untouched.
+func (ir *InstallResources) save(ctx context.Context, obj client.Object) error {
+	if ir.render {
+		ir.manifests = append(ir.manifests, obj)
+		return nil
+	}
+
+	err := ir.kubeCtl.Create(ctx, obj)
+	if !ir.upgrade || !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	desired, err := toApplyUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	if desired.GetKind() == "Deployment" {
+		unstructured.RemoveNestedField(desired.Object, "spec", "replicas")
+	}
+
+	// the fields of the previous version were set by the installation, which did not use the field manager
+	return ir.kubeCtl.Apply(ctx, client.ApplyConfigurationFromUnstructured(desired), client.FieldOwner(FieldManager), client.ForceOwnership)
+}
+
 func parseYaml(data io.ReadCloser) ([]map[string]any, error) {
 	var k8sResources []map[string]any
 
@@ -380,12 +481,7 @@ func joinNamespaces(namespace string, watched []string) string {
 		unique[watch] = struct{}{}
 	}
 
-	list := make([]string, 0, len(unique))
-	for item := range unique {
-		list = append(list, item)
-	}
-
-	return strings.Join(list, ",")
+	return strings.Join(slices.Sorted(maps.Keys(unique)), ",")
 }
 
 func isLeaderElectionResource(config map[string]any, leaderElectionID string) bool {
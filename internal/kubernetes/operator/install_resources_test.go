@@ -0,0 +1,85 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestInstallResources_RenderManifests(t *testing.T) {
+	ctx := context.Background()
+	installer := NewInstaller(&releaseVersionProvider{version: "2.14.0"}, nil, false, true).WithRenderManifests(true)
+
+	require.NoError(t, installer.InstallNamespace(ctx, "atlas"))
+	require.NoError(t, installer.InstallCRDs(ctx, "2.14.0", true))
+	require.NoError(t, installer.InstallConfiguration(ctx, &InstallConfig{
+		Version:                              "2.14.0",
+		Namespace:                            "atlas",
+		Watch:                                []string{"apps"},
+		ResourceDeletionProtectionEnabled:    false,
+		SubResourceDeletionProtectionEnabled: true,
+		AtlasGov:                             true,
+	}))
+	require.NoError(t, installer.InstallCredentials(ctx, "atlas", "org-id", Credentials{PublicKey: "public", PrivateKey: "private"}, ""))
+
+	rendered := make([]string, 0, len(installer.Manifests()))
+	for _, obj := range installer.Manifests() {
+		o := obj.(client.Object)
+		rendered = append(rendered, obj.GetObjectKind().GroupVersionKind().Kind+" "+o.GetNamespace()+"/"+o.GetName())
+	}
+	assert.Equal(t, []string{
+		"Namespace /atlas",
+		"CustomResourceDefinition /atlasprojects.atlas.mongodb.com",
+		"ServiceAccount atlas/mongodb-atlas-operator",
+		"Role apps/mongodb-atlas-manager-role",
+		"Role atlas/mongodb-atlas-manager-role",
+		"Deployment atlas/mongodb-atlas-operator",
+		"Secret atlas/mongodb-atlas-operator-api-key",
+	}, rendered)
+
+	deployment := installer.Manifests()[5].(*appsv1.Deployment)
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{"--atlas-domain=https://cloud.mongodbgov.com/", "--leader-elect", "--object-deletion-protection=false"}, container.Args)
+	assert.Equal(t, []corev1.EnvVar{{Name: "WATCH_NAMESPACE", Value: "apps,atlas"}}, container.Env)
+
+	manifests, err := SerializeObjects(installer.Manifests())
+	require.NoError(t, err)
+	assert.Contains(t, manifests, "kind: Secret")
+	assert.Contains(t, manifests, "publicApiKey: public")
+	assert.Contains(t, manifests, "value: apps,atlas")
+}
+
+func TestInstallResources_InstallNamespace(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewClientBuilder().Build()
+	installer := NewInstaller(nil, kubernetes.NewKubeCtlFromClient(k8sClient), false, false)
+
+	require.NoError(t, installer.InstallNamespace(ctx, "atlas"))
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "atlas"}, &corev1.Namespace{}))
+
+	// an existing namespace is kept
+	require.NoError(t, installer.InstallNamespace(ctx, "atlas"))
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/atlas-sdk/v20250312006/admin"
 )
@@ -71,3 +72,89 @@ func TestInstall_addAPIKeyIPAccessList(t *testing.T) {
 		})
 	}
 }
+
+func TestInstall_addServiceAccountIPAccessList(t *testing.T) {
+	tests := map[string]struct {
+		ipAccessList    string
+		expectedEntries []admin.ServiceAccountIPAccessListEntry
+		expectedErr     error
+	}{
+		"Multiple entries are provided": {
+			ipAccessList: "104.30.164.5,192.168.100.177/24",
+			expectedEntries: []admin.ServiceAccountIPAccessListEntry{
+				{IpAddress: pointer.Get("104.30.164.5")},
+				{CidrBlock: pointer.Get("192.168.100.177/24")},
+			},
+			expectedErr: nil,
+		},
+		"API failed to add ip access list": {
+			ipAccessList: "104.30.164.5",
+			expectedEntries: []admin.ServiceAccountIPAccessListEntry{
+				{IpAddress: pointer.Get("104.30.164.5")},
+			},
+			expectedErr: fmt.Errorf("failed to add IP access list to service account: %w", errors.New("failed to add IP access list")),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			storeMock := mocks.NewMockOperatorGenericStore(gomock.NewController(t))
+			storeMock.EXPECT().AddServiceAccountIPAccessList("orgID", "clientID", &tt.expectedEntries).
+				DoAndReturn(func(string, string, *[]admin.ServiceAccountIPAccessListEntry) error {
+					if tt.expectedErr != nil {
+						return errors.New("failed to add IP access list")
+					}
+
+					return nil
+				}).
+				Times(1)
+
+			i := &Install{
+				ipAccessList: tt.ipAccessList,
+				atlasStore:   storeMock,
+			}
+			err := i.addServiceAccountIPAccessList("orgID", "clientID")
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestInstall_generateServiceAccount(t *testing.T) {
+	tests := map[string]struct {
+		maxValidity      *int
+		expectedValidity int
+	}{
+		"default validity": {
+			expectedValidity: serviceAccountSecretValidityInHours,
+		},
+		"validity capped by the organization": {
+			maxValidity:      pointer.Get(24),
+			expectedValidity: 24,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			storeMock := mocks.NewMockOperatorGenericStore(gomock.NewController(t))
+			storeMock.EXPECT().GetOrgSettings("orgID").
+				Return(&admin.OrganizationSettings{MaxServiceAccountSecretValidityInHours: tt.maxValidity}, nil)
+			storeMock.EXPECT().CreateOrganizationServiceAccount("orgID", &admin.OrgServiceAccountRequest{
+				Name:                    credentialsGlobalName,
+				Description:             credentialsGlobalName,
+				SecretExpiresAfterHours: tt.expectedValidity,
+				Roles:                   []string{roleOrgGroupCreator},
+			}).Return(&admin.OrgServiceAccount{
+				ClientId: pointer.Get("clientID"),
+				Secrets: &[]admin.ServiceAccountSecret{
+					{Secret: pointer.Get("clientSecret")},
+				},
+			}, nil)
+
+			i := &Install{
+				atlasStore:     storeMock,
+				serviceAccount: true,
+			}
+			got, err := i.generateServiceAccount("orgID")
+			assert.NoError(t, err)
+			assert.Equal(t, &Credentials{ClientID: "clientID", ClientSecret: "clientSecret"}, got)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Installation is an operator installed in a cluster.
+type Installation struct {
+	Namespace string `json:"namespace"`
+	// Version is empty when the operator does not run the operator image, e.g. OpenShift certified installations.
+	Version string `json:"version,omitempty"`
+	// WatchNamespaces is empty when the operator watches every namespace.
+	WatchNamespaces []string           `json:"watchNamespaces,omitempty"`
+	Deployment      *appsv1.Deployment `json:"-"`
+}
+
+// Watches reports whether the operator manages the resources of a namespace.
+func (i *Installation) Watches(namespace string) bool {
+	return len(i.WatchNamespaces) == 0 || slices.Contains(i.WatchNamespaces, namespace)
+}
+
+func (i *Installation) String() string {
+	watched := "all namespaces"
+	if len(i.WatchNamespaces) > 0 {
+		watched = strings.Join(i.WatchNamespaces, ",")
+	}
+
+	version := i.Version
+	if version == "" {
+		version = "unknown version"
+	}
+
+	return fmt.Sprintf("%s (%s, watching %s)", i.Namespace, version, watched)
+}
+
+// FindInstallations returns every operator installed in the accessible namespaces of the cluster.
+func FindInstallations(ctx context.Context, kubectl *kubernetes.KubeCtl) ([]Installation, error) {
+	deployments, err := kubectl.FindAtlasOperators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	installations := make([]Installation, 0, len(deployments))
+	for i := range deployments {
+		installations = append(installations, newInstallation(&deployments[i]))
+	}
+
+	return installations, nil
+}
+
+// newInstallation describes the operator run by a deployment.
+func newInstallation(deployment *appsv1.Deployment) Installation {
+	installation := Installation{
+		Namespace:  deployment.Namespace,
+		Deployment: deployment,
+	}
+
+	if containers := deployment.Spec.Template.Spec.Containers; len(containers) > 0 {
+		installation.WatchNamespaces = watchNamespaces(deployment.Namespace, containers[0])
+
+		separator := strings.LastIndex(containers[0].Image, ":")
+		if separator >= 0 && strings.HasSuffix(containers[0].Image[:separator], operatorImage) {
+			installation.Version = containers[0].Image[separator+1:]
+		}
+	}
+
+	return installation
+}
+
+// InstallationsFor returns the installations managing the resources of a namespace. An operator installed
+// in the namespace comes first, followed by the operators watching it.
+func InstallationsFor(installations []Installation, namespace string) []Installation {
+	var candidates []Installation
+	for _, installation := range installations {
+		if !installation.Watches(namespace) {
+			continue
+		}
+
+		if installation.Namespace == namespace {
+			candidates = append([]Installation{installation}, candidates...)
+		} else {
+			candidates = append(candidates, installation)
+		}
+	}
+
+	return candidates
+}
+
+// FindInstallationFor returns the operator managing the resources of a namespace. The operator installed in the
+// namespace is looked up first, as it only needs access to that namespace. The operators installed in the other
+// namespaces are only looked up when the namespace has none, or can not be read.
+func FindInstallationFor(ctx context.Context, kubectl *kubernetes.KubeCtl, namespace string) (*Installation, error) {
+	deployment, err := findOperatorDeployment(ctx, kubectl, namespace)
+	switch {
+	case err == nil:
+		if installation := newInstallation(deployment); installation.Watches(namespace) {
+			return &installation, nil
+		}
+	case !errors.Is(err, errOperatorNotInstalled) && !apierrors.IsForbidden(err):
+		return nil, err
+	}
+
+	installations, err := FindInstallations(ctx, kubectl)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't find an operator installed in namespace %s: %w", namespace, err)
+	}
+
+	candidates := InstallationsFor(installations, namespace)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("couldn't find an operator watching namespace %s", namespace)
+	}
+
+	return &candidates[0], nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestFindInstallations(t *testing.T) {
+	ctx := context.Background()
+
+	tenantOperator := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "tenant", Labels: kubernetes.AtlasOperatorLabels},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "manager",
+						Image: "registry.connect.redhat.com/mongodb/mongodb-atlas-kubernetes-operator@sha256:0123",
+						Env: []corev1.EnvVar{{
+							Name:      "WATCH_NAMESPACE",
+							ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}},
+						}},
+					}},
+				},
+			},
+		},
+	}
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "apps"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "atlas"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "tenant"}},
+	}
+
+	kubectl := kubernetes.NewKubeCtlFromClient(newInstalledOperator(t, namespaces[0], namespaces[1], namespaces[2], tenantOperator))
+
+	installations, err := FindInstallations(ctx, kubectl)
+	require.NoError(t, err)
+	require.Len(t, installations, 2)
+
+	assert.Equal(t, "atlas", installations[0].Namespace)
+	assert.Equal(t, "2.13.0", installations[0].Version)
+	assert.Equal(t, []string{"atlas", "apps"}, installations[0].WatchNamespaces)
+	assert.Equal(t, "atlas (2.13.0, watching atlas,apps)", installations[0].String())
+
+	assert.Equal(t, "tenant", installations[1].Namespace)
+	assert.Empty(t, installations[1].Version)
+	assert.Equal(t, []string{"tenant"}, installations[1].WatchNamespaces)
+
+	assert.Equal(t, []string{"atlas"}, namespacesOf(InstallationsFor(installations, "apps")))
+	assert.Equal(t, []string{"tenant"}, namespacesOf(InstallationsFor(installations, "tenant")))
+	assert.Empty(t, InstallationsFor(installations, "other"))
+
+	_, err = kubectl.FindAtlasOperator(ctx)
+	require.ErrorIs(t, err, kubernetes.ErrMultipleOperators)
+	assert.ErrorContains(t, err, "atlas, tenant")
+}
+
+func TestInstallationsFor(t *testing.T) {
+	installations := []Installation{
+		{Namespace: "cluster-wide"},
+		{Namespace: "apps", WatchNamespaces: []string{"apps"}},
+		{Namespace: "atlas", WatchNamespaces: []string{"atlas", "apps"}},
+	}
+
+	assert.Equal(t, []string{"apps", "cluster-wide", "atlas"}, namespacesOf(InstallationsFor(installations, "apps")))
+	assert.Equal(t, []string{"cluster-wide"}, namespacesOf(InstallationsFor(installations, "other")))
+}
+
+func TestFindInstallationFor(t *testing.T) {
+	ctx := context.Background()
+	namespaces := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "atlas"}},
+	}
+
+	t.Run("finds the operator watching the namespace", func(t *testing.T) {
+		kubectl := kubernetes.NewKubeCtlFromClient(newInstalledOperator(t, namespaces...))
+
+		installation, err := FindInstallationFor(ctx, kubectl, "atlas")
+		require.NoError(t, err)
+		assert.Equal(t, "atlas", installation.Namespace)
+
+		installation, err = FindInstallationFor(ctx, kubectl, "apps")
+		require.NoError(t, err)
+		assert.Equal(t, "atlas", installation.Namespace)
+
+		_, err = FindInstallationFor(ctx, kubectl, "other")
+		require.ErrorContains(t, err, "couldn't find an operator watching namespace other")
+	})
+
+	t.Run("only reads the namespace the operator is installed in", func(t *testing.T) {
+		// a user allowed to read the atlas namespace only
+		k8sClient := interceptor.NewClient(newInstalledOperator(t, namespaces...).(client.WithWatch), interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+				if listOpts.Namespace != "atlas" {
+					return apierrors.NewForbidden(schema.GroupResource{Resource: "deployments"}, "", nil)
+				}
+
+				return c.List(ctx, list, opts...)
+			},
+		})
+		kubectl := kubernetes.NewKubeCtlFromClient(k8sClient)
+
+		installation, err := FindInstallationFor(ctx, kubectl, "atlas")
+		require.NoError(t, err)
+		assert.Equal(t, "atlas", installation.Namespace)
+
+		_, err = FindInstallationFor(ctx, kubectl, "apps")
+		require.ErrorContains(t, err, "couldn't find an operator installed in namespace apps")
+	})
+}
+
+func namespacesOf(installations []Installation) []string {
+	namespaces := make([]string, 0, len(installations))
+	for _, installation := range installations {
+		namespaces = append(namespaces, installation.Namespace)
+	}
+
+	return namespaces
+}
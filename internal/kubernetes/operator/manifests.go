@@ -0,0 +1,252 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	kustomizationFile       = "kustomization.yaml"
+	kustomizationAPIVersion = "kustomize.config.k8s.io/v1beta1"
+	kustomizationKind       = "Kustomization"
+	manifestDirPermissions  = 0o755
+	manifestFilePermissions = 0o644
+)
+
+type ManifestAction string
+
+const (
+	ManifestActionWritten   ManifestAction = "written"
+	ManifestActionUnchanged ManifestAction = "unchanged"
+	ManifestActionRemoved   ManifestAction = "removed"
+)
+
+// ManifestResult describes what happened to a single file in the output directory.
+type ManifestResult struct {
+	Path   string         `json:"path"`
+	Action ManifestAction `json:"action"`
+}
+
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Resources  []string `json:"resources"`
+}
+
+// ManifestWriter writes exported objects to a directory, one file per object,
+// organised as [<namespace>/]<kind>/<name>.yaml, along with a kustomization.yaml listing them.
+type ManifestWriter struct {
+	fs            afero.Fs
+	dir           string
+	namespaceDirs bool
+}
+
+func NewManifestWriter(fs afero.Fs, dir string) *ManifestWriter {
+	return &ManifestWriter{
+		fs:            fs,
+		dir:           dir,
+		namespaceDirs: true,
+	}
+}
+
+// WithNamespaceDirs controls whether files are placed in a directory per namespace.
+// It can be disabled when the output directory already belongs to a single namespace.
+func (w *ManifestWriter) WithNamespaceDirs(enabled bool) *ManifestWriter {
+	w.namespaceDirs = enabled
+	return w
+}
+
+// Write serializes the objects into the output directory. Files are rewritten only when
+// their content changed, and files listed by a previous kustomization.yaml that are no
+// longer exported are removed, so re-running an export produces minimal git diffs.
+func (w *ManifestWriter) Write(objects []runtime.Object) ([]ManifestResult, error) {
+	serializer := json.NewSerializerWithOptions(
+		json.DefaultMetaFactory,
+		scheme.Scheme,
+		scheme.Scheme,
+		json.SerializerOptions{Yaml: true, Pretty: true},
+	)
+
+	previous, err := w.readKustomization()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(objects))
+	contents := make([][]byte, 0, len(objects))
+	for _, obj := range objects {
+		path, err := manifestPath(obj, w.namespaceDirs)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(paths, path) {
+			return nil, fmt.Errorf("more than one object would be written to %s", path)
+		}
+
+		content := &bytes.Buffer{}
+		if err = serializer.Encode(obj, content); err != nil {
+			return nil, fmt.Errorf("failed to serialize %s: %w", path, err)
+		}
+		paths = append(paths, path)
+		contents = append(contents, content.Bytes())
+	}
+
+	results := make([]ManifestResult, 0, len(objects)+1)
+	for i, path := range paths {
+		action, err := w.writeFile(path, contents[i])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, ManifestResult{Path: path, Action: action})
+	}
+
+	var kept []string
+	for _, path := range previous {
+		if slices.Contains(paths, path) || slices.Contains(kept, path) {
+			continue
+		}
+		if !isManifestPath(path) {
+			_, _ = log.Warningf("Keeping %s listed by %s, it was not written by an export\n", path, kustomizationFile)
+			kept = append(kept, path)
+			continue
+		}
+		if err = w.fs.Remove(filepath.Join(w.dir, filepath.FromSlash(path))); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return nil, fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		results = append(results, ManifestResult{Path: path, Action: ManifestActionRemoved})
+	}
+
+	paths = append(paths, kept...)
+	slices.Sort(paths)
+	content, err := yaml.Marshal(kustomization{
+		APIVersion: kustomizationAPIVersion,
+		Kind:       kustomizationKind,
+		Resources:  paths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize %s: %w", kustomizationFile, err)
+	}
+
+	action, err := w.writeFile(kustomizationFile, content)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, ManifestResult{Path: kustomizationFile, Action: action})
+
+	return results, nil
+}
+
+// readKustomization returns the resources listed by an existing kustomization.yaml, if any.
+func (w *ManifestWriter) readKustomization() ([]string, error) {
+	path := filepath.Join(w.dir, kustomizationFile)
+	exists, err := afero.Exists(w.fs, path)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	content, err := afero.ReadFile(w.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", kustomizationFile, err)
+	}
+
+	k := kustomization{}
+	if err = yaml.Unmarshal(content, &k); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", kustomizationFile, err)
+	}
+
+	return k.Resources, nil
+}
+
+func (w *ManifestWriter) writeFile(path string, content []byte) (ManifestAction, error) {
+	fullPath := filepath.Join(w.dir, filepath.FromSlash(path))
+
+	exists, err := afero.Exists(w.fs, fullPath)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		current, err := afero.ReadFile(w.fs, fullPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if bytes.Equal(current, content) {
+			return ManifestActionUnchanged, nil
+		}
+	}
+
+	if err = w.fs.MkdirAll(filepath.Dir(fullPath), manifestDirPermissions); err != nil {
+		return "", fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	if err = afero.WriteFile(w.fs, fullPath, content, manifestFilePermissions); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return ManifestActionWritten, nil
+}
+
+// isManifestPath tells whether a resource listed by a kustomization.yaml has the [<namespace>/]<kind>/<name>.yaml
+// layout of the files written by an export. Other entries, such as absolute paths or paths leaving the output
+// directory, were added by hand and are never removed.
+func isManifestPath(path string) bool {
+	if path == "" || filepath.IsAbs(path) || strings.Contains(path, "\\") || filepath.Clean(path) != filepath.FromSlash(path) {
+		return false
+	}
+
+	elements := strings.Split(path, "/")
+	if len(elements) < 2 || len(elements) > 3 || !strings.HasSuffix(path, ".yaml") {
+		return false
+	}
+	for _, element := range elements {
+		if element == "" || element == "." || element == ".." {
+			return false
+		}
+	}
+
+	return true
+}
+
+// manifestPath returns the slash separated path, relative to the output directory, of the object's file.
+func manifestPath(obj runtime.Object, namespaceDirs bool) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", fmt.Errorf("unable to write resource: %w", err)
+	}
+
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" || accessor.GetName() == "" {
+		return "", fmt.Errorf("unable to write resource %q of kind %q: kind and name are required", accessor.GetName(), kind)
+	}
+
+	elements := []string{strings.ToLower(kind), accessor.GetName() + ".yaml"}
+	if namespaceDirs && accessor.GetNamespace() != "" {
+		elements = append([]string{accessor.GetNamespace()}, elements...)
+	}
+
+	return strings.Join(elements, "/"), nil
+}
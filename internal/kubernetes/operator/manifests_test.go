@@ -0,0 +1,156 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"strings"
+	"testing"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestManifestWriter_Write(t *testing.T) {
+	newProject := func(projectName string) *akov2.AtlasProject {
+		return &akov2.AtlasProject{
+			TypeMeta:   metav1.TypeMeta{Kind: "AtlasProject", APIVersion: "atlas.mongodb.com/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "test"},
+			Spec:       akov2.AtlasProjectSpec{Name: projectName},
+		}
+	}
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "test"},
+	}
+
+	fs := afero.NewMemMapFs()
+	writer := NewManifestWriter(fs, "out")
+
+	t.Run("should write one file per object and a kustomization", func(t *testing.T) {
+		results, err := writer.Write([]runtime.Object{newProject("Project 1"), secret})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "test/atlasproject/my-project.yaml", Action: ManifestActionWritten},
+			{Path: "test/secret/my-secret.yaml", Action: ManifestActionWritten},
+			{Path: kustomizationFile, Action: ManifestActionWritten},
+		}, results)
+
+		project, err := afero.ReadFile(fs, "out/test/atlasproject/my-project.yaml")
+		require.NoError(t, err)
+		assert.Contains(t, string(project), "name: Project 1")
+
+		kustomization, err := afero.ReadFile(fs, "out/kustomization.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- test/atlasproject/my-project.yaml
+- test/secret/my-secret.yaml
+`, string(kustomization))
+	})
+
+	t.Run("should only rewrite changed files", func(t *testing.T) {
+		results, err := writer.Write([]runtime.Object{newProject("Project 2"), secret})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "test/atlasproject/my-project.yaml", Action: ManifestActionWritten},
+			{Path: "test/secret/my-secret.yaml", Action: ManifestActionUnchanged},
+			{Path: kustomizationFile, Action: ManifestActionUnchanged},
+		}, results)
+	})
+
+	t.Run("should remove files no longer exported", func(t *testing.T) {
+		results, err := writer.Write([]runtime.Object{newProject("Project 2")})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "test/atlasproject/my-project.yaml", Action: ManifestActionUnchanged},
+			{Path: "test/secret/my-secret.yaml", Action: ManifestActionRemoved},
+			{Path: kustomizationFile, Action: ManifestActionWritten},
+		}, results)
+
+		exists, err := afero.Exists(fs, "out/test/secret/my-secret.yaml")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("should only remove files laid out by an export", func(t *testing.T) {
+		outside := map[string]string{
+			".ssh/id_rsa":              "../.ssh/id_rsa",
+			"/etc/passwd":              "/etc/passwd",
+			"out/base/deployment.yaml": "base/../base/deployment.yaml",
+			"out/notes.txt":            "notes.txt",
+			"out/a/b/c/d.yaml":         "a/b/c/d.yaml",
+		}
+		resources := []string{"test/atlasproject/my-project.yaml"}
+		for file, entry := range outside {
+			require.NoError(t, afero.WriteFile(fs, file, []byte("keep"), 0o600))
+			resources = append(resources, entry)
+		}
+		require.NoError(t, afero.WriteFile(fs, "out/"+kustomizationFile,
+			[]byte("resources:\n- "+strings.Join(resources, "\n- ")+"\n"), 0o600))
+
+		results, err := writer.Write([]runtime.Object{newProject("Project 2")})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "test/atlasproject/my-project.yaml", Action: ManifestActionUnchanged},
+			{Path: kustomizationFile, Action: ManifestActionWritten},
+		}, results)
+
+		for file := range outside {
+			exists, err := afero.Exists(fs, file)
+			require.NoError(t, err)
+			assert.True(t, exists, file)
+		}
+	})
+
+	t.Run("should keep the entries added to the kustomization", func(t *testing.T) {
+		require.NoError(t, afero.WriteFile(fs, "out/configmap.yaml", []byte("keep"), 0o600))
+		require.NoError(t, afero.WriteFile(fs, "out/"+kustomizationFile,
+			[]byte("resources:\n- configmap.yaml\n- test/atlasproject/my-project.yaml\n- ../shared\n"), 0o600))
+
+		_, err := writer.Write([]runtime.Object{newProject("Project 2")})
+		require.NoError(t, err)
+
+		content, err := afero.ReadFile(fs, "out/"+kustomizationFile)
+		require.NoError(t, err)
+		assert.Equal(t, "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n- ../shared\n- configmap.yaml\n- test/atlasproject/my-project.yaml\n", string(content))
+
+		exists, err := afero.Exists(fs, "out/configmap.yaml")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("should not nest files in namespace directories when disabled", func(t *testing.T) {
+		results, err := NewManifestWriter(fs, "project").WithNamespaceDirs(false).Write([]runtime.Object{NewNamespace("test"), newProject("Project 1")})
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestResult{
+			{Path: "namespace/test.yaml", Action: ManifestActionWritten},
+			{Path: "atlasproject/my-project.yaml", Action: ManifestActionWritten},
+			{Path: kustomizationFile, Action: ManifestActionWritten},
+		}, results)
+	})
+
+	t.Run("should fail when two objects share a file", func(t *testing.T) {
+		_, err := writer.Write([]runtime.Object{newProject("Project 1"), newProject("Project 2")})
+		require.ErrorContains(t, err, "more than one object would be written to test/atlasproject/my-project.yaml")
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"cmp"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectFilter selects the projects of an organization to export.
+// Empty fields match every project.
+type ProjectFilter struct {
+	// NameGlob is a shell pattern, as understood by path.Match, the project name has to match
+	NameGlob string
+	// Tags lists the tags, as key and value, the project has to carry
+	Tags map[string]string
+}
+
+// OrgProject is a project exported as part of an organization-wide export,
+// along with the namespace its resources are exported to.
+type OrgProject struct {
+	ID        string
+	Name      string
+	Namespace string
+}
+
+// ValidateProjectFilter fails when the name pattern is malformed.
+func ValidateProjectFilter(filter ProjectFilter) error {
+	if _, err := path.Match(filter.NameGlob, ""); err != nil {
+		return fmt.Errorf("invalid project name pattern %q: %w", filter.NameGlob, err)
+	}
+
+	return nil
+}
+
+// ListOrgProjects returns the projects of the organization matching the filter, sorted by name.
+// Each project is exported to its own namespace, named after the project.
+func ListOrgProjects(provider store.OrgProjectLister, orgID string, filter ProjectFilter) ([]OrgProject, error) {
+	if err := ValidateProjectFilter(filter); err != nil {
+		return nil, err
+	}
+
+	projects, err := provider.AllOrgProjects(orgID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve list of projects: %w", err)
+	}
+
+	result := make([]OrgProject, 0, len(projects))
+	for _, project := range projects {
+		if !filter.matches(project.GetName(), project.GetTags()) {
+			continue
+		}
+
+		result = append(result, OrgProject{
+			ID:   project.GetId(),
+			Name: project.GetName(),
+		})
+	}
+
+	slices.SortFunc(result, func(a, b OrgProject) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	// different project names may normalize to the same namespace, e.g. "My Project" and "my project"
+	dictionary := resources.AtlasNameToKubernetesName()
+	namespaces := map[string]struct{}{}
+	for i := range result {
+		namespace := resources.NormalizeAtlasName(result[i].Name, dictionary)
+		if _, ok := namespaces[namespace]; ok {
+			namespace = resources.AppendHashSuffix(namespace, result[i].ID)
+		}
+		namespaces[namespace] = struct{}{}
+		result[i].Namespace = namespace
+	}
+
+	return result, nil
+}
+
+func (f ProjectFilter) matches(name string, tags []admin.ResourceTag) bool {
+	if f.NameGlob != "" {
+		if ok, _ := path.Match(f.NameGlob, name); !ok {
+			return false
+		}
+	}
+
+	for key, value := range f.Tags {
+		if !slices.ContainsFunc(tags, func(tag admin.ResourceTag) bool {
+			return tag.Key == key && tag.Value == value
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewNamespace builds the namespace holding the resources of a project exported organization-wide.
+func NewNamespace(name string) *corev1.Namespace {
+	return &corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Namespace",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
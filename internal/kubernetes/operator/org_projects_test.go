@@ -0,0 +1,92 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+)
+
+func TestListOrgProjects(t *testing.T) {
+	const orgID = "org-id"
+
+	projects := []admin.Group{
+		{Id: pointer.Get("3"), Name: "staging-app"},
+		{Id: pointer.Get("1"), Name: "prod-app", Tags: &[]admin.ResourceTag{{Key: "environment", Value: "production"}}},
+		{Id: pointer.Get("2"), Name: "prod app", Tags: &[]admin.ResourceTag{{Key: "environment", Value: "production"}, {Key: "team", Value: "a"}}},
+		{Id: pointer.Get("4"), Name: "Prod App"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   ProjectFilter
+		expected []OrgProject
+	}{
+		{
+			name:   "should return every project sorted by name with a namespace each",
+			filter: ProjectFilter{},
+			expected: []OrgProject{
+				{ID: "4", Name: "Prod App", Namespace: "prod-app"},
+				{ID: "2", Name: "prod app", Namespace: resources.AppendHashSuffix("prod-app", "2")},
+				{ID: "1", Name: "prod-app", Namespace: resources.AppendHashSuffix("prod-app", "1")},
+				{ID: "3", Name: "staging-app", Namespace: "staging-app"},
+			},
+		},
+		{
+			name:   "should filter by name pattern",
+			filter: ProjectFilter{NameGlob: "prod*"},
+			expected: []OrgProject{
+				{ID: "2", Name: "prod app", Namespace: "prod-app"},
+				{ID: "1", Name: "prod-app", Namespace: resources.AppendHashSuffix("prod-app", "1")},
+			},
+		},
+		{
+			name:   "should filter by every given tag",
+			filter: ProjectFilter{Tags: map[string]string{"environment": "production", "team": "a"}},
+			expected: []OrgProject{
+				{ID: "2", Name: "prod app", Namespace: "prod-app"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			projectLister := mocks.NewMockOrgProjectLister(ctl)
+			projectLister.EXPECT().AllOrgProjects(orgID).Return(projects, nil)
+
+			got, err := ListOrgProjects(projectLister, orgID, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	t.Run("should reject a malformed name pattern", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		projectLister := mocks.NewMockOrgProjectLister(ctl)
+
+		_, err := ListOrgProjects(projectLister, orgID, ProjectFilter{NameGlob: "prod-["})
+		require.ErrorContains(t, err, "invalid project name pattern")
+	})
+}
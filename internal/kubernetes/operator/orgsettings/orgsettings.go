@@ -36,19 +36,8 @@ func BuildAtlasOrgSettings(orgID string, provider store.OrgSettingsStore, creds
 	}
 
 	secret := secrets.NewAtlasSecretBuilder(fmt.Sprintf("orgsettings-%s", orgID), targetNs, dict).
-		WithData(map[string][]byte{
-			secrets.CredOrgID:         []byte(""),
-			secrets.CredPublicAPIKey:  []byte(""),
-			secrets.CredPrivateAPIKey: []byte(""),
-		}).
+		WithData(secrets.CredentialsData(creds, orgID, includeSecretData)).
 		Build()
-	if includeSecretData {
-		secret.Data = map[string][]byte{
-			secrets.CredOrgID:         []byte(orgID),
-			secrets.CredPublicAPIKey:  []byte(creds.PublicAPIKey()),
-			secrets.CredPrivateAPIKey: []byte(creds.PrivateAPIKey()),
-		}
-	}
 
 	return &akov2.AtlasOrgSettings{
 		TypeMeta: metav1.TypeMeta{
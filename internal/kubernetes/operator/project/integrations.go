@@ -40,6 +40,7 @@ type ThirdPartyIntegrationRequest struct {
 	Version             string
 	Credentials         string
 	IndependentResource bool
+	IncludeSecrets      bool
 	Dictionary          map[string]string
 }
 
@@ -196,7 +197,7 @@ func newIntegrationSecret(request ThirdPartyIntegrationRequest, secretName, fiel
 
 func newIntegrationRawSecret(request ThirdPartyIntegrationRequest, secretName string, data map[string][]byte) *corev1.Secret {
 	return secrets.NewAtlasSecretBuilder(secretName, request.TargetNamespace, request.Dictionary).
-		WithData(data).WithProjectLabels(request.ProjectID, request.ProjectName).Build()
+		WithData(data).WithSecretsData(request.IncludeSecrets).WithProjectLabels(request.ProjectID, request.ProjectName).Build()
 }
 
 func dashit(s string) string {
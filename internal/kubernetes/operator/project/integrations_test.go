@@ -668,6 +668,7 @@ func TestBuildIntegrations(t *testing.T) {
 					Version:             version,
 					Credentials:         credentialName,
 					IndependentResource: tc.independentResource,
+					IncludeSecrets:      true,
 					Dictionary:          dictionary,
 				},
 			)
@@ -676,3 +677,40 @@ func TestBuildIntegrations(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildIntegrationsWithoutSecrets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	intStore := mocks.NewMockIntegrationLister(ctl)
+	intStore.EXPECT().Integrations("project-int-id").Return([]admin.ThirdPartyIntegration{
+		{
+			Id:         pointer.Get("integration-id"),
+			Type:       pointer.Get("NEW_RELIC"),
+			AccountId:  pointer.Get("fake-account-id"),
+			LicenseKey: pointer.Get("fake-license-key"),
+			ReadToken:  pointer.Get("fake-read-token"),
+			WriteToken: pointer.Get("fake-write-token"),
+		},
+	}, nil)
+
+	objects, err := BuildThirdPartyIntegrations(
+		intStore,
+		ThirdPartyIntegrationRequest{
+			ProjectName:     "projectName-int",
+			ProjectID:       "project-int-id",
+			TargetNamespace: "intNamespace",
+			Version:         features.LatestOperatorMajorVersion,
+			Credentials:     "int-creds",
+			Dictionary:      resources.AtlasNameToKubernetesName(),
+		},
+	)
+	require.NoError(t, err)
+
+	secret, ok := objects[1].(*corev1.Secret)
+	require.True(t, ok)
+	assert.Equal(t, map[string][]byte{
+		"accountId":  {},
+		"licenseKey": {},
+		"readToken":  {},
+		"writeToken": {},
+	}, secret.Data)
+}
@@ -116,7 +116,7 @@ func BuildAtlasProject(br *AtlasProjectBuildRequest) (*AtlasProjectResult, error
 	projectResult.Spec.ConnectionSecret = secretRef
 
 	if br.Validator.FeatureExist(features.ResourceAtlasProject, featureIntegrations) && !br.Validator.IsResourceSupported(features.ResourceAtlasThirdPartyIntegration) {
-		integrations, intSecrets, ferr := buildIntegrations(br.ProjectStore, br.ProjectID, br.TargetNamespace, true, br.Dictionary)
+		integrations, intSecrets, ferr := buildIntegrations(br.ProjectStore, br.ProjectID, br.TargetNamespace, br.IncludeSecret, br.Dictionary)
 		if ferr != nil {
 			return nil, ferr
 		}
@@ -141,7 +141,7 @@ func BuildAtlasProject(br *AtlasProjectBuildRequest) (*AtlasProjectResult, error
 	}
 
 	if br.Validator.FeatureExist(features.ResourceAtlasProject, featureEncryptionAtRest) {
-		encryptionAtRest, s, ferr := buildEncryptionAtRest(br.ProjectStore, br.ProjectID, br.Project.Name, br.TargetNamespace, br.Dictionary)
+		encryptionAtRest, s, ferr := buildEncryptionAtRest(br.ProjectStore, br.ProjectID, br.Project.Name, br.TargetNamespace, br.IncludeSecret, br.Dictionary)
 		if ferr != nil {
 			return nil, ferr
 		}
@@ -257,21 +257,9 @@ func newAtlasProject(project *atlasv2.Group, dictionary map[string]string, targe
 }
 
 func BuildProjectNamedConnectionSecret(credsProvider store.CredentialsGetter, name, namespace, orgID string, includeCreds bool, dictionary map[string]string) *corev1.Secret {
-	secret := secrets.NewAtlasSecretBuilder(name, namespace, dictionary).
-		WithData(map[string][]byte{
-			secrets.CredOrgID:         []byte(""),
-			secrets.CredPublicAPIKey:  []byte(""),
-			secrets.CredPrivateAPIKey: []byte(""),
-		}).
+	return secrets.NewAtlasSecretBuilder(name, namespace, dictionary).
+		WithData(secrets.CredentialsData(credsProvider, orgID, includeCreds)).
 		Build()
-	if includeCreds {
-		secret.Data = map[string][]byte{
-			secrets.CredOrgID:         []byte(orgID),
-			secrets.CredPublicAPIKey:  []byte(credsProvider.PublicAPIKey()),
-			secrets.CredPrivateAPIKey: []byte(credsProvider.PrivateAPIKey()),
-		}
-	}
-	return secret
 }
 
 func projectBuildCustomRoles(crProvider store.DatabaseRoleLister, projectID string) ([]akov2.CustomRole, error) {
@@ -427,10 +415,10 @@ func buildIntegrations(intProvider store.IntegrationLister, projectID, targetNam
 			}
 		case victorOpsIntegrationType: // One more secret required
 			integration.APIKeyRef = secretRef
-			secret.Data[secrets.PasswordField] = []byte(list.GetApiKey())
 
 			var routingKeyData string
 			if includeSecrets {
+				secret.Data[secrets.PasswordField] = []byte(list.GetApiKey())
 				routingKeyData = list.GetRoutingKey()
 			}
 			if list.GetRoutingKey() != "" {
@@ -444,10 +432,10 @@ func buildIntegrations(intProvider store.IntegrationLister, projectID, targetNam
 			}
 		case newRelicIntegrationType:
 			integration.LicenseKeyRef = secretRef
-			secret.Data[secrets.PasswordField] = []byte(list.GetLicenseKey())
 			// Secrets with write and read tokens
 			var writeToken, readToken string
 			if includeSecrets {
+				secret.Data[secrets.PasswordField] = []byte(list.GetLicenseKey())
 				writeToken = list.GetWriteToken()
 				readToken = list.GetReadToken()
 			}
@@ -553,7 +541,9 @@ func convertGCPNetworkPeer(np *atlasv2.BaseNetworkPeeringConnectionSettings, pro
 	}
 }
 
-func buildEncryptionAtRest(encProvider store.EncryptionAtRestDescriber, projectID, projectName, targetNamespace string, dictionary map[string]string) (*akov2.EncryptionAtRest, []*corev1.Secret, error) {
+// buildEncryptionAtRest converts the encryption at rest settings. The key management credentials are only
+// filled in when secrets are included, Atlas does not return the write-only ones such as access keys.
+func buildEncryptionAtRest(encProvider store.EncryptionAtRestDescriber, projectID, projectName, targetNamespace string, includeSecrets bool, dictionary map[string]string) (*akov2.EncryptionAtRest, []*corev1.Secret, error) {
 	data, err := encProvider.EncryptionAtRest(projectID)
 	if err != nil {
 		return nil, nil, err
@@ -586,7 +576,11 @@ func buildEncryptionAtRest(encProvider store.EncryptionAtRestDescriber, projectI
 		}
 
 		ss = append(ss, secrets.NewAtlasSecretBuilder(ref.AwsKms.SecretRef.Name, ref.AwsKms.SecretRef.Namespace, dictionary).
-			WithData(map[string][]byte{"CustomerMasterKeyID": []byte(""), "RoleID": []byte("")}).
+			WithData(map[string][]byte{
+				"CustomerMasterKeyID": []byte(data.AwsKms.GetCustomerMasterKeyID()),
+				"RoleID":              []byte(data.AwsKms.GetRoleId()),
+			}).
+			WithSecretsData(includeSecrets).
 			WithProjectLabels(projectID, projectName).
 			Build())
 
@@ -597,7 +591,13 @@ func buildEncryptionAtRest(encProvider store.EncryptionAtRestDescriber, projectI
 		}
 
 		ss = append(ss, secrets.NewAtlasSecretBuilder(ref.AzureKeyVault.SecretRef.Name, ref.AzureKeyVault.SecretRef.Namespace, dictionary).
-			WithData(map[string][]byte{"SubscriptionID": []byte(""), "KeyVaultName": []byte(""), "KeyIdentifier": []byte(""), "Secret": []byte("")}).
+			WithData(map[string][]byte{
+				"SubscriptionID": []byte(data.AzureKeyVault.GetSubscriptionID()),
+				"KeyVaultName":   []byte(data.AzureKeyVault.GetKeyVaultName()),
+				"KeyIdentifier":  []byte(data.AzureKeyVault.GetKeyIdentifier()),
+				"Secret":         []byte(data.AzureKeyVault.GetSecret()),
+			}).
+			WithSecretsData(includeSecrets).
 			WithProjectLabels(projectID, projectName).
 			Build())
 
@@ -608,7 +608,11 @@ func buildEncryptionAtRest(encProvider store.EncryptionAtRestDescriber, projectI
 		}
 
 		ss = append(ss, secrets.NewAtlasSecretBuilder(ref.GoogleCloudKms.SecretRef.Name, ref.GoogleCloudKms.SecretRef.Namespace, dictionary).
-			WithData(map[string][]byte{"ServiceAccountKey": []byte(""), "KeyVersionResourceID": []byte("")}).
+			WithData(map[string][]byte{
+				"ServiceAccountKey":    []byte(data.GoogleCloudKms.GetServiceAccountKey()),
+				"KeyVersionResourceID": []byte(data.GoogleCloudKms.GetKeyVersionResourceID()),
+			}).
+			WithSecretsData(includeSecrets).
 			WithProjectLabels(projectID, projectName).
 			Build())
 	}
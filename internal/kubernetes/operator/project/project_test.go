@@ -26,6 +26,7 @@ import (
 
 	"github.com/go-test/deep"
 	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
@@ -736,6 +737,7 @@ func TestBuildProjectConnectionSecret(t *testing.T) {
 		name := "TestSecret-1"
 		namespace := "TestNamespace-1"
 
+		credsProvider.EXPECT().AuthType().Return(config.APIKeys)
 		credsProvider.EXPECT().PublicAPIKey().Return(publicAPIKey)
 		credsProvider.EXPECT().PrivateAPIKey().Return(privateAPIKey)
 
@@ -765,10 +767,49 @@ func TestBuildProjectConnectionSecret(t *testing.T) {
 			t.Fatalf("Credentials secret mismatch.\r\nexpected: %v\r\ngot: %v\r\n", expected, got)
 		}
 	})
+	t.Run("Can generate a valid connection secret WITH service account data", func(t *testing.T) {
+		clientID := "TestClientID"
+		clientSecret := "TestClientSecret"
+
+		name := "TestSecret-2"
+		namespace := "TestNamespace-2"
+
+		credsProvider.EXPECT().AuthType().Return(config.ServiceAccount)
+		credsProvider.EXPECT().ClientID().Return(clientID)
+		credsProvider.EXPECT().ClientSecret().Return(clientSecret)
+
+		got := BuildProjectNamedConnectionSecret(credsProvider, name+credentialSuffix, namespace,
+			orgID, true, dictionary)
+
+		expected := &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      strings.ToLower(name + credentialSuffix),
+				Namespace: namespace,
+				Labels: map[string]string{
+					secrets.TypeLabelKey: secrets.CredLabelVal,
+				},
+			},
+			Data: map[string][]byte{
+				secrets.CredOrgID:        []byte(orgID),
+				secrets.CredClientID:     []byte(clientID),
+				secrets.CredClientSecret: []byte(clientSecret),
+			},
+		}
+
+		if !reflect.DeepEqual(expected, got) {
+			t.Fatalf("Credentials secret mismatch.\r\nexpected: %v\r\ngot: %v\r\n", expected, got)
+		}
+	})
 	t.Run("Can generate a valid connection secret WITHOUT data", func(t *testing.T) {
 		name := "TestSecret"
 		namespace := "TestNamespace"
 
+		credsProvider.EXPECT().AuthType().Return(config.APIKeys)
+
 		got := BuildProjectNamedConnectionSecret(credsProvider, name+credentialSuffix, namespace,
 			orgID, false, dictionary)
 
@@ -933,7 +974,7 @@ func Test_buildEncryptionAtREST(t *testing.T) {
 
 		dataProvider.EXPECT().EncryptionAtRest(projectID).Return(data, nil)
 
-		got, _, err := buildEncryptionAtRest(dataProvider, projectID, testProjectName, targetNamespace, dictionary)
+		got, _, err := buildEncryptionAtRest(dataProvider, projectID, testProjectName, targetNamespace, false, dictionary)
 		if err != nil {
 			t.Errorf("%v", err)
 		}
@@ -977,7 +1018,7 @@ func Test_buildEncryptionAtREST(t *testing.T) {
 		}
 
 		dataProvider.EXPECT().EncryptionAtRest(projectID).Return(data, nil)
-		got, _, err := buildEncryptionAtRest(dataProvider, projectID, testProjectName, targetNamespace, dictionary)
+		got, _, err := buildEncryptionAtRest(dataProvider, projectID, testProjectName, targetNamespace, false, dictionary)
 		if err != nil {
 			t.Errorf("%v", err)
 		}
@@ -1026,7 +1067,7 @@ func Test_buildEncryptionAtREST(t *testing.T) {
 		}
 
 		dataProvider.EXPECT().EncryptionAtRest(projectID).Return(&data, nil)
-		got, _, err := buildEncryptionAtRest(dataProvider, projectID, testProjectName, targetNamespace, dictionary)
+		got, _, err := buildEncryptionAtRest(dataProvider, projectID, testProjectName, targetNamespace, false, dictionary)
 		if err != nil {
 			t.Errorf("%v", err)
 		}
@@ -0,0 +1,454 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312018/admin"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const passwordKey = "password"
+
+// curatedKinds are the curated CRD kinds which can be pushed. Curated resources are converted to Atlas Admin API
+// requests through the JSON names they share with the API, the way the operator converts database users.
+var curatedKinds = map[string]func() client.Object{
+	"AtlasProject":      func() client.Object { return &akov2.AtlasProject{} },
+	"AtlasDeployment":   func() client.Object { return &akov2.AtlasDeployment{} },
+	"AtlasDatabaseUser": func() client.Object { return &akov2.AtlasDatabaseUser{} },
+	"AtlasIPAccessList": func() client.Object { return &akov2.AtlasIPAccessList{} },
+}
+
+// skipper is implemented by handlers which can only push some of the resources of their kind.
+type skipper interface {
+	skipReason(obj client.Object) string
+}
+
+// projectHandler pushes the project of an AtlasProject. The project settings, access lists, integrations and the
+// other sub-resources of the AtlasProject spec are not pushed.
+type projectHandler struct {
+	orgID string
+}
+
+func (projectHandler) find(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, _ []client.Object) (bool, error) {
+	project, ok := obj.(*akov2.AtlasProject)
+	if !ok {
+		return false, errors.New("not an AtlasProject")
+	}
+
+	atlasGroup, resp, err := c.ProjectsApi.GetGroupByName(ctx, project.Spec.Name).Execute()
+	exists, err := found(resp, err)
+	if err != nil {
+		return false, err
+	}
+
+	// dependent resources resolve their projectRef from the status of the project
+	project.Status.ID = pendingGroupID
+	if exists {
+		project.Status.ID = atlasGroup.GetId()
+	}
+
+	return exists, nil
+}
+
+func (h projectHandler) create(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, _ []client.Object) error {
+	project, ok := obj.(*akov2.AtlasProject)
+	if !ok {
+		return errors.New("not an AtlasProject")
+	}
+	if h.orgID == "" {
+		return errors.New("an organization ID is required to create a project")
+	}
+
+	group := admin.Group{
+		Name:                      project.Spec.Name,
+		OrgId:                     h.orgID,
+		WithDefaultAlertsSettings: pointer.Get(project.Spec.WithDefaultAlertsSettings),
+	}
+	if project.Spec.RegionUsageRestrictions != "" {
+		group.RegionUsageRestrictions = pointer.Get(project.Spec.RegionUsageRestrictions)
+	}
+
+	created, _, err := c.ProjectsApi.CreateGroup(ctx, &group).Execute()
+	if err != nil {
+		return err
+	}
+	project.Status.ID = created.GetId()
+
+	return nil
+}
+
+func (projectHandler) update(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, _ []client.Object) error {
+	project, ok := obj.(*akov2.AtlasProject)
+	if !ok || project.Status.ID == "" {
+		return errors.New("project ID is unknown")
+	}
+
+	_, _, err := c.ProjectsApi.UpdateGroup(ctx, project.Status.ID, &admin.GroupUpdate{Name: pointer.Get(project.Spec.Name)}).Execute()
+	return err
+}
+
+func (projectHandler) createCall(_ client.Object) string { return "POST /api/atlas/v2/groups" }
+func (projectHandler) updateCall(_ client.Object) string {
+	return "PATCH /api/atlas/v2/groups/{groupId}"
+}
+
+// deploymentHandler pushes the advanced or flex cluster of an AtlasDeployment. Process arguments, search nodes,
+// search indexes and global cluster zone mappings are not pushed.
+type deploymentHandler struct{}
+
+func (deploymentHandler) skipReason(obj client.Object) string {
+	deployment, ok := obj.(*akov2.AtlasDeployment)
+	if ok && (deployment.Spec.DeploymentSpec != nil || deployment.Spec.FlexSpec != nil) {
+		return ""
+	}
+
+	return "only advanced and flex deployments can be pushed"
+}
+
+func (deploymentHandler) find(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	deployment, groupID, err := curatedDeployment(obj, deps)
+	if err != nil || groupID == pendingGroupID {
+		return false, err
+	}
+
+	if deployment.Spec.FlexSpec != nil {
+		_, resp, err := c.FlexClustersApi.GetFlexCluster(ctx, groupID, deployment.Spec.FlexSpec.Name).Execute()
+		return found(resp, err)
+	}
+
+	_, resp, err := c.ClustersApi.GetCluster(ctx, groupID, deployment.Spec.DeploymentSpec.Name).Execute()
+	return found(resp, err)
+}
+
+func (deploymentHandler) create(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) error {
+	deployment, groupID, err := curatedDeployment(obj, deps)
+	if err != nil {
+		return err
+	}
+
+	if deployment.Spec.FlexSpec != nil {
+		cluster := admin.FlexClusterDescriptionCreate20241113{}
+		if err := jsonCopy(&cluster, deployment.Spec.FlexSpec); err != nil {
+			return err
+		}
+		_, _, err := c.FlexClustersApi.CreateFlexCluster(ctx, groupID, &cluster).Execute()
+		return err
+	}
+
+	cluster, err := clusterDescription(deployment.Spec.DeploymentSpec)
+	if err != nil {
+		return err
+	}
+	_, _, err = c.ClustersApi.CreateCluster(ctx, groupID, cluster).Execute()
+	return err
+}
+
+func (deploymentHandler) update(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) error {
+	deployment, groupID, err := curatedDeployment(obj, deps)
+	if err != nil {
+		return err
+	}
+
+	if flex := deployment.Spec.FlexSpec; flex != nil {
+		cluster := admin.FlexClusterDescriptionUpdate20241113{}
+		if err := jsonCopy(&cluster, flex); err != nil {
+			return err
+		}
+		_, _, err := c.FlexClustersApi.UpdateFlexCluster(ctx, groupID, flex.Name, &cluster).Execute()
+		return err
+	}
+
+	cluster, err := clusterDescription(deployment.Spec.DeploymentSpec)
+	if err != nil {
+		return err
+	}
+	_, _, err = c.ClustersApi.UpdateCluster(ctx, groupID, deployment.Spec.DeploymentSpec.Name, cluster).Execute()
+	return err
+}
+
+func (deploymentHandler) createCall(obj client.Object) string {
+	if deployment, ok := obj.(*akov2.AtlasDeployment); ok && deployment.Spec.FlexSpec != nil {
+		return flexClusterHandler{}.createCall(obj)
+	}
+	return clusterHandler{}.createCall(obj)
+}
+
+func (deploymentHandler) updateCall(obj client.Object) string {
+	if deployment, ok := obj.(*akov2.AtlasDeployment); ok && deployment.Spec.FlexSpec != nil {
+		return flexClusterHandler{}.updateCall(obj)
+	}
+	return clusterHandler{}.updateCall(obj)
+}
+
+func curatedDeployment(obj client.Object, deps []client.Object) (*akov2.AtlasDeployment, string, error) {
+	deployment, ok := obj.(*akov2.AtlasDeployment)
+	if !ok {
+		return nil, "", errors.New("not an AtlasDeployment")
+	}
+
+	groupID, err := curatedProjectID(deployment, deps)
+	return deployment, groupID, err
+}
+
+// clusterDescription converts an advanced deployment spec. The spec holds a single disk size, which the API
+// expects in the hardware specification of every region.
+func clusterDescription(spec *akov2.AdvancedDeploymentSpec) (*admin.ClusterDescription20240805, error) {
+	cluster := &admin.ClusterDescription20240805{}
+	if err := jsonCopy(cluster, spec); err != nil {
+		return nil, err
+	}
+
+	if spec.DiskSizeGB != nil {
+		diskSizeGB := float64(*spec.DiskSizeGB)
+		for _, replicationSpec := range cluster.GetReplicationSpecs() {
+			for _, regionConfig := range replicationSpec.GetRegionConfigs() {
+				if regionConfig.ElectableSpecs != nil {
+					regionConfig.ElectableSpecs.DiskSizeGB = &diskSizeGB
+				}
+				if regionConfig.ReadOnlySpecs != nil {
+					regionConfig.ReadOnlySpecs.DiskSizeGB = &diskSizeGB
+				}
+				if regionConfig.AnalyticsSpecs != nil {
+					regionConfig.AnalyticsSpecs.DiskSizeGB = &diskSizeGB
+				}
+			}
+		}
+	}
+
+	return cluster, nil
+}
+
+type curatedDatabaseUserHandler struct{}
+
+func (curatedDatabaseUserHandler) find(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	user, err := curatedDatabaseUser(obj, deps)
+	if err != nil || user.GroupId == pendingGroupID {
+		return false, err
+	}
+
+	_, resp, err := c.DatabaseUsersApi.GetDatabaseUser(ctx, user.GroupId, user.DatabaseName, user.Username).Execute()
+	return found(resp, err)
+}
+
+func (curatedDatabaseUserHandler) create(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) error {
+	user, err := curatedDatabaseUser(obj, deps)
+	if err != nil {
+		return err
+	}
+
+	// exports empty the Secret values unless they include them, and a user can't be created without its password
+	if passwordSecret := obj.(*akov2.AtlasDatabaseUser).Spec.PasswordSecret; passwordSecret != nil && user.Password == nil {
+		return fmt.Errorf("password secret %s is empty", passwordSecret.Name)
+	}
+
+	_, _, err = c.DatabaseUsersApi.CreateDatabaseUser(ctx, user.GroupId, user).Execute()
+	return err
+}
+
+func (curatedDatabaseUserHandler) update(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) error {
+	user, err := curatedDatabaseUser(obj, deps)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.DatabaseUsersApi.UpdateDatabaseUser(ctx, user.GroupId, user.DatabaseName, user.Username, user).Execute()
+	return err
+}
+
+func (curatedDatabaseUserHandler) createCall(obj client.Object) string {
+	return databaseUserHandler{}.createCall(obj)
+}
+func (curatedDatabaseUserHandler) updateCall(obj client.Object) string {
+	return databaseUserHandler{}.updateCall(obj)
+}
+
+// curatedDatabaseUser converts an AtlasDatabaseUser, reading its password from the Secret it references. The
+// password is left unset when the Secret is empty, so an update keeps the password of the user.
+func curatedDatabaseUser(obj client.Object, deps []client.Object) (*admin.CloudDatabaseUser, error) {
+	user, ok := obj.(*akov2.AtlasDatabaseUser)
+	if !ok {
+		return nil, errors.New("not an AtlasDatabaseUser")
+	}
+
+	groupID, err := curatedProjectID(user, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &admin.CloudDatabaseUser{}
+	if err := jsonCopy(result, user.Spec); err != nil {
+		return nil, err
+	}
+	result.GroupId = groupID
+	// the CRD and the API spell the AWS IAM type differently
+	if user.Spec.AWSIAMType != "" {
+		result.AwsIAMType = pointer.Get(user.Spec.AWSIAMType)
+	}
+
+	if user.Spec.PasswordSecret != nil {
+		password, err := secretValue(deps, user.Namespace, user.Spec.PasswordSecret.Name, passwordKey)
+		if err != nil {
+			return nil, err
+		}
+		if password != "" {
+			result.Password = &password
+		}
+	}
+
+	return result, nil
+}
+
+// ipAccessListHandler pushes every entry of an AtlasIPAccessList. Adding entries also updates the comment
+// or expiry of existing ones, so the same call creates and updates the list.
+type ipAccessListHandler struct{}
+
+func (ipAccessListHandler) find(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	entries, groupID, err := curatedAccessListEntries(obj, deps)
+	if err != nil || groupID == pendingGroupID {
+		return false, err
+	}
+
+	for i := range entries {
+		_, resp, err := c.ProjectIPAccessListApi.GetAccessListEntry(ctx, groupID, entryValue(&entries[i])).Execute()
+		if exists, err := found(resp, err); err != nil || !exists {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (ipAccessListHandler) create(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, deps []client.Object) error {
+	entries, groupID, err := curatedAccessListEntries(obj, deps)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.ProjectIPAccessListApi.CreateAccessListEntry(ctx, groupID, &entries).Execute()
+	return err
+}
+
+func (h ipAccessListHandler) update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	return h.create(ctx, c, tr, obj, deps)
+}
+
+func (ipAccessListHandler) createCall(obj client.Object) string {
+	return ipAccessListEntryHandler{}.createCall(obj)
+}
+func (ipAccessListHandler) updateCall(obj client.Object) string {
+	return ipAccessListEntryHandler{}.updateCall(obj)
+}
+
+func curatedAccessListEntries(obj client.Object, deps []client.Object) ([]admin.NetworkPermissionEntry, string, error) {
+	accessList, ok := obj.(*akov2.AtlasIPAccessList)
+	if !ok {
+		return nil, "", errors.New("not an AtlasIPAccessList")
+	}
+
+	groupID, err := curatedProjectID(accessList, deps)
+	if err != nil {
+		return nil, "", err
+	}
+
+	entries := make([]admin.NetworkPermissionEntry, 0, len(accessList.Spec.Entries))
+	for _, entry := range accessList.Spec.Entries {
+		atlasEntry := admin.NetworkPermissionEntry{}
+		if entry.IPAddress != "" {
+			atlasEntry.IpAddress = pointer.Get(entry.IPAddress)
+		}
+		if entry.CIDRBlock != "" {
+			atlasEntry.CidrBlock = pointer.Get(entry.CIDRBlock)
+		}
+		if entry.AwsSecurityGroup != "" {
+			atlasEntry.AwsSecurityGroup = pointer.Get(entry.AwsSecurityGroup)
+		}
+		if entry.Comment != "" {
+			atlasEntry.Comment = pointer.Get(entry.Comment)
+		}
+		if entry.DeleteAfterDate != nil {
+			atlasEntry.DeleteAfterDate = pointer.Get(entry.DeleteAfterDate.UTC().Truncate(time.Second))
+		}
+		entries = append(entries, atlasEntry)
+	}
+
+	return entries, groupID, nil
+}
+
+// curatedProjectID resolves the project a curated resource belongs to, either from its external project ID or
+// from the AtlasProject it references, which is planned first.
+func curatedProjectID(obj interface {
+	client.Object
+	ProjectDualRef() *akov2.ProjectDualReference
+}, deps []client.Object) (string, error) {
+	ref := obj.ProjectDualRef()
+	if ref.ExternalProjectRef != nil && ref.ExternalProjectRef.ID != "" {
+		return ref.ExternalProjectRef.ID, nil
+	}
+	if ref.ProjectRef == nil {
+		return "", fmt.Errorf("%s has no project reference", obj.GetName())
+	}
+
+	namespace := ref.ProjectRef.Namespace
+	if namespace == "" {
+		namespace = obj.GetNamespace()
+	}
+	for _, dep := range deps {
+		project, ok := dep.(*akov2.AtlasProject)
+		if ok && project.Name == ref.ProjectRef.Name && project.Namespace == namespace && project.Status.ID != "" {
+			return project.Status.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("can not find AtlasProject %s referenced by %s in the manifests", ref.ProjectRef.Name, obj.GetName())
+}
+
+func secretValue(deps []client.Object, namespace, name, key string) (string, error) {
+	for _, dep := range deps {
+		secret, ok := dep.(*corev1.Secret)
+		if !ok || secret.Name != name || secret.Namespace != namespace {
+			continue
+		}
+		if value, ok := secret.Data[key]; ok {
+			return string(value), nil
+		}
+		if value, ok := secret.StringData[key]; ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("secret %s has no %s key", name, key)
+	}
+
+	return "", fmt.Errorf("can not find secret %s in the manifests", name)
+}
+
+// jsonCopy copies the fields of a curated spec to an API model through their JSON names.
+// Fields the API model does not have are dropped.
+func jsonCopy(dst, src any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
@@ -0,0 +1,358 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	generated "github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter/generated"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312018/admin"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pendingGroupID stands for the ID of a group that is created by the same push
+const pendingGroupID = "pending"
+
+// handler knows how to find, create and update the Atlas resource of a CRD kind.
+type handler interface {
+	find(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) (bool, error)
+	create(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error
+	update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error
+	createCall(obj client.Object) string
+	updateCall(obj client.Object) string
+}
+
+// kindOrder is the order in which resources are pushed, groups and projects first as the others belong to them.
+var kindOrder = []string{
+	"Group", "AtlasProject",
+	"Cluster", "FlexCluster", "AtlasDeployment",
+	"DatabaseUser", "AtlasDatabaseUser",
+	"IPAccessListEntry", "AtlasIPAccessList",
+}
+
+var handlers = map[string]handler{
+	"Group":             groupHandler{},
+	"Cluster":           clusterHandler{},
+	"FlexCluster":       flexClusterHandler{},
+	"DatabaseUser":      databaseUserHandler{},
+	"IPAccessListEntry": ipAccessListEntryHandler{},
+	"AtlasDeployment":   deploymentHandler{},
+	"AtlasDatabaseUser": curatedDatabaseUserHandler{},
+	"AtlasIPAccessList": ipAccessListHandler{},
+}
+
+// found tells whether a GET call found its resource, treating a 404 as not found rather than as a failure.
+func found(resp *http.Response, err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	return false, err
+}
+
+type groupHandler struct{}
+
+func (groupHandler) find(ctx context.Context, c *admin.APIClient, _ crapi.Translator, obj client.Object, _ []client.Object) (bool, error) {
+	group, ok := obj.(*akov2generated.Group)
+	if !ok || group.Spec.V20250312 == nil || group.Spec.V20250312.Entry == nil {
+		return false, errors.New("group has no v20250312 entry")
+	}
+
+	// exported groups carry their ID, others are looked up by name
+	exists := false
+	var atlasGroup *admin.Group
+	if id := group.GetAnnotations()[generated.ExternalIDAnnotation]; id != "" {
+		var resp *http.Response
+		var err error
+		atlasGroup, resp, err = c.ProjectsApi.GetGroup(ctx, id).Execute()
+		if exists, err = found(resp, err); err != nil {
+			return false, err
+		}
+	}
+	if !exists {
+		var resp *http.Response
+		var err error
+		atlasGroup, resp, err = c.ProjectsApi.GetGroupByName(ctx, group.Spec.V20250312.Entry.Name).Execute()
+		if exists, err = found(resp, err); err != nil {
+			return false, err
+		}
+	}
+
+	// dependent resources resolve their groupRef from the status of the group
+	if exists {
+		setGroupID(group, atlasGroup.GetId())
+	} else {
+		setGroupID(group, pendingGroupID)
+	}
+
+	return exists, nil
+}
+
+func (groupHandler) create(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	group := admin.Group{}
+	if err := tr.ToAPI(&group, obj, deps...); err != nil {
+		return err
+	}
+	params := struct {
+		ProjectOwnerID *string `json:"projectOwnerId"`
+	}{}
+	if err := tr.ToAPI(&params, obj, deps...); err != nil {
+		return err
+	}
+
+	req := c.ProjectsApi.CreateGroup(ctx, &group)
+	if params.ProjectOwnerID != nil {
+		req = req.ProjectOwnerId(*params.ProjectOwnerID)
+	}
+	created, _, err := req.Execute()
+	if err != nil {
+		return err
+	}
+
+	if g, ok := obj.(*akov2generated.Group); ok {
+		setGroupID(g, created.GetId())
+	}
+
+	return nil
+}
+
+func (groupHandler) update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	update := admin.GroupUpdate{}
+	if err := tr.ToAPI(&update, obj, deps...); err != nil {
+		return err
+	}
+	// default alert settings can only be chosen on creation
+	update.WithDefaultAlertsSettings = nil
+
+	group, ok := obj.(*akov2generated.Group)
+	if !ok || group.Status.V20250312 == nil || group.Status.V20250312.Id == nil {
+		return errors.New("group ID is unknown")
+	}
+
+	_, _, err := c.ProjectsApi.UpdateGroup(ctx, *group.Status.V20250312.Id, &update).Execute()
+	return err
+}
+
+func (groupHandler) createCall(_ client.Object) string { return "POST /api/atlas/v2/groups" }
+func (groupHandler) updateCall(_ client.Object) string { return "PATCH /api/atlas/v2/groups/{groupId}" }
+
+func setGroupID(group *akov2generated.Group, id string) {
+	if group.Status.V20250312 == nil {
+		group.Status.V20250312 = &akov2generated.GroupStatusV20250312{}
+	}
+	group.Status.V20250312.Id = &id
+}
+
+type clusterHandler struct{}
+
+func (clusterHandler) find(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	cluster := admin.ClusterDescription20240805{}
+	if err := tr.ToAPI(&cluster, obj, deps...); err != nil {
+		return false, err
+	}
+	if cluster.GetGroupId() == pendingGroupID {
+		return false, nil
+	}
+
+	_, resp, err := c.ClustersApi.GetCluster(ctx, cluster.GetGroupId(), cluster.GetName()).Execute()
+	return found(resp, err)
+}
+
+func (clusterHandler) create(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	cluster := admin.ClusterDescription20240805{}
+	if err := tr.ToAPI(&cluster, obj, deps...); err != nil {
+		return err
+	}
+	groupID := cluster.GetGroupId()
+	cluster.GroupId = nil
+
+	_, _, err := c.ClustersApi.CreateCluster(ctx, groupID, &cluster).Execute()
+	return err
+}
+
+func (clusterHandler) update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	cluster := admin.ClusterDescription20240805{}
+	if err := tr.ToAPI(&cluster, obj, deps...); err != nil {
+		return err
+	}
+	groupID := cluster.GetGroupId()
+	cluster.GroupId = nil
+
+	_, _, err := c.ClustersApi.UpdateCluster(ctx, groupID, cluster.GetName(), &cluster).Execute()
+	return err
+}
+
+func (clusterHandler) createCall(_ client.Object) string {
+	return "POST /api/atlas/v2/groups/{groupId}/clusters"
+}
+func (clusterHandler) updateCall(_ client.Object) string {
+	return "PATCH /api/atlas/v2/groups/{groupId}/clusters/{clusterName}"
+}
+
+// flexClusterID holds the fields identifying a flex cluster, as the flex cluster requests do not carry the group ID.
+type flexClusterID struct {
+	GroupID string `json:"groupId"`
+	Name    string `json:"name"`
+}
+
+type flexClusterHandler struct{}
+
+func (flexClusterHandler) find(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	id := flexClusterID{}
+	if err := tr.ToAPI(&id, obj, deps...); err != nil {
+		return false, err
+	}
+	if id.GroupID == pendingGroupID {
+		return false, nil
+	}
+
+	_, resp, err := c.FlexClustersApi.GetFlexCluster(ctx, id.GroupID, id.Name).Execute()
+	return found(resp, err)
+}
+
+func (flexClusterHandler) create(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	id := flexClusterID{}
+	if err := tr.ToAPI(&id, obj, deps...); err != nil {
+		return err
+	}
+	cluster := admin.FlexClusterDescriptionCreate20241113{}
+	if err := tr.ToAPI(&cluster, obj, deps...); err != nil {
+		return err
+	}
+
+	_, _, err := c.FlexClustersApi.CreateFlexCluster(ctx, id.GroupID, &cluster).Execute()
+	return err
+}
+
+func (flexClusterHandler) update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	id := flexClusterID{}
+	if err := tr.ToAPI(&id, obj, deps...); err != nil {
+		return err
+	}
+	cluster := admin.FlexClusterDescriptionUpdate20241113{}
+	if err := tr.ToAPI(&cluster, obj, deps...); err != nil {
+		return err
+	}
+
+	_, _, err := c.FlexClustersApi.UpdateFlexCluster(ctx, id.GroupID, id.Name, &cluster).Execute()
+	return err
+}
+
+func (flexClusterHandler) createCall(_ client.Object) string {
+	return "POST /api/atlas/v2/groups/{groupId}/flexClusters"
+}
+func (flexClusterHandler) updateCall(_ client.Object) string {
+	return "PATCH /api/atlas/v2/groups/{groupId}/flexClusters/{name}"
+}
+
+type databaseUserHandler struct{}
+
+func (databaseUserHandler) find(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	user := admin.CloudDatabaseUser{}
+	if err := tr.ToAPI(&user, obj, deps...); err != nil {
+		return false, err
+	}
+	if user.GroupId == pendingGroupID {
+		return false, nil
+	}
+
+	_, resp, err := c.DatabaseUsersApi.GetDatabaseUser(ctx, user.GroupId, user.DatabaseName, user.Username).Execute()
+	return found(resp, err)
+}
+
+func (databaseUserHandler) create(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	user := admin.CloudDatabaseUser{}
+	if err := tr.ToAPI(&user, obj, deps...); err != nil {
+		return err
+	}
+
+	_, _, err := c.DatabaseUsersApi.CreateDatabaseUser(ctx, user.GroupId, &user).Execute()
+	return err
+}
+
+func (databaseUserHandler) update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	user := admin.CloudDatabaseUser{}
+	if err := tr.ToAPI(&user, obj, deps...); err != nil {
+		return err
+	}
+
+	_, _, err := c.DatabaseUsersApi.UpdateDatabaseUser(ctx, user.GroupId, user.DatabaseName, user.Username, &user).Execute()
+	return err
+}
+
+func (databaseUserHandler) createCall(_ client.Object) string {
+	return "POST /api/atlas/v2/groups/{groupId}/databaseUsers"
+}
+func (databaseUserHandler) updateCall(_ client.Object) string {
+	return "PATCH /api/atlas/v2/groups/{groupId}/databaseUsers/{databaseName}/{username}"
+}
+
+type ipAccessListEntryHandler struct{}
+
+// entryValue returns the address, CIDR block or security group identifying an access list entry.
+func entryValue(entry *admin.NetworkPermissionEntry) string {
+	switch {
+	case entry.IpAddress != nil:
+		return entry.GetIpAddress()
+	case entry.CidrBlock != nil:
+		return entry.GetCidrBlock()
+	default:
+		return entry.GetAwsSecurityGroup()
+	}
+}
+
+func (ipAccessListEntryHandler) find(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) (bool, error) {
+	entry := admin.NetworkPermissionEntry{}
+	if err := tr.ToAPI(&entry, obj, deps...); err != nil {
+		return false, err
+	}
+	if entry.GetGroupId() == pendingGroupID {
+		return false, nil
+	}
+
+	_, resp, err := c.ProjectIPAccessListApi.GetAccessListEntry(ctx, entry.GetGroupId(), entryValue(&entry)).Execute()
+	return found(resp, err)
+}
+
+// create adds the entry to the access list, which also updates the comment or expiry of an existing entry.
+func (ipAccessListEntryHandler) create(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	entry := admin.NetworkPermissionEntry{}
+	if err := tr.ToAPI(&entry, obj, deps...); err != nil {
+		return err
+	}
+	groupID := entry.GetGroupId()
+	entry.GroupId = nil
+
+	_, _, err := c.ProjectIPAccessListApi.CreateAccessListEntry(ctx, groupID, &[]admin.NetworkPermissionEntry{entry}).Execute()
+	return err
+}
+
+func (h ipAccessListEntryHandler) update(ctx context.Context, c *admin.APIClient, tr crapi.Translator, obj client.Object, deps []client.Object) error {
+	return h.create(ctx, c, tr, obj, deps)
+}
+
+func (ipAccessListEntryHandler) createCall(_ client.Object) string {
+	return "POST /api/atlas/v2/groups/{groupId}/accessList"
+}
+func (ipAccessListEntryHandler) updateCall(_ client.Object) string {
+	return "POST /api/atlas/v2/groups/{groupId}/accessList"
+}
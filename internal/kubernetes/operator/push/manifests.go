@@ -0,0 +1,102 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const decoderBufferSize = 4096
+
+// ReadManifests reads the Kubernetes objects of a YAML or JSON file, or of every such file in a directory and its subdirectories.
+func ReadManifests(fs afero.Fs, path string) ([]*unstructured.Unstructured, error) {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("can not read manifests from %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return readManifestFile(fs, path)
+	}
+
+	var objects []*unstructured.Unstructured
+	err = afero.Walk(fs, path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !isManifestFile(filePath) {
+			return nil
+		}
+
+		fileObjects, err := readManifestFile(fs, filePath)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, fileObjects...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func readManifestFile(fs afero.Fs, path string) ([]*unstructured.Unstructured, error) {
+	file, err := fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var objects []*unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(file, decoderBufferSize)
+	for {
+		document := map[string]any{}
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+
+		// skip empty documents, such as the one before a leading separator
+		if len(document) == 0 {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: document})
+	}
+
+	return objects, nil
+}
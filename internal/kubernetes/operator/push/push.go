@@ -0,0 +1,190 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312018/admin"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const curatedGroup = "atlas.mongodb.com"
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionSkip   Action = "skip"
+)
+
+// Step is a single Atlas Admin API call of a plan.
+type Step struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Action Action `json:"action"`
+	Call   string `json:"call,omitempty"`
+	Reason string `json:"reason,omitempty"`
+
+	object client.Object
+}
+
+// Plan lists the steps needed to bring Atlas to the state described by a set of manifests, in the order they run.
+type Plan struct {
+	Steps []Step `json:"steps"`
+
+	dependencies []client.Object
+}
+
+// Pusher plans and applies the Atlas Admin API calls that create or update the resources of curated or generated
+// CRD manifests.
+type Pusher struct {
+	client      *admin.APIClient
+	scheme      *runtime.Scheme
+	translators map[string]crapi.Translator
+	orgID       string
+}
+
+func NewPusher(client *admin.APIClient, scheme *runtime.Scheme, translators map[string]crapi.Translator) *Pusher {
+	return &Pusher{
+		client:      client,
+		scheme:      scheme,
+		translators: translators,
+	}
+}
+
+// WithOrgID sets the organization the projects of AtlasProject resources are created in. Generated Group
+// resources carry their own organization ID.
+func (p *Pusher) WithOrgID(orgID string) *Pusher {
+	p.orgID = orgID
+	return p
+}
+
+// Plan finds which resources already exist in Atlas and returns the calls needed to create or update each of them.
+// Resources of unknown kinds, and curated resources whose spec can not be pushed, are reported as skipped.
+func (p *Pusher) Plan(ctx context.Context, manifests []*unstructured.Unstructured) (*Plan, error) {
+	plan := &Plan{}
+	var resources []client.Object
+
+	for _, manifest := range manifests {
+		gvk := manifest.GroupVersionKind()
+		switch {
+		case gvk.Group == "" && gvk.Kind == "Secret":
+			secret := &corev1.Secret{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Object, secret); err != nil {
+				return nil, fmt.Errorf("failed to read secret %s: %w", manifest.GetName(), err)
+			}
+			plan.dependencies = append(plan.dependencies, secret)
+		case gvk.Group == curatedGroup && curatedKinds[gvk.Kind] != nil:
+			resource := curatedKinds[gvk.Kind]()
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Object, resource); err != nil {
+				return nil, fmt.Errorf("failed to read %s %s: %w", gvk.Kind, manifest.GetName(), err)
+			}
+			if partial, ok := p.handler(gvk.Kind).(skipper); ok {
+				if reason := partial.skipReason(resource); reason != "" {
+					plan.Steps = append(plan.Steps, skip(gvk.Kind, manifest.GetName(), reason))
+					continue
+				}
+			}
+			resources = append(resources, resource)
+		case gvk.Group == akov2generated.GroupVersion.Group && p.translators[gvk.Kind] != nil && handlers[gvk.Kind] != nil:
+			obj, err := p.scheme.New(gvk)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create %s %s: %w", gvk.Kind, manifest.GetName(), err)
+			}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Object, obj); err != nil {
+				return nil, fmt.Errorf("failed to read %s %s: %w", gvk.Kind, manifest.GetName(), err)
+			}
+			resource, ok := obj.(client.Object)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a Kubernetes object", gvk.Kind)
+			}
+			resources = append(resources, resource)
+		default:
+			plan.Steps = append(plan.Steps, skip(gvk.Kind, manifest.GetName(), "unsupported kind"))
+		}
+	}
+
+	// resources referencing a group must be planned after the group, so its ID is known
+	slices.SortStableFunc(resources, func(a, b client.Object) int {
+		return slices.Index(kindOrder, kind(a)) - slices.Index(kindOrder, kind(b))
+	})
+	plan.dependencies = append(plan.dependencies, resources...)
+
+	for _, resource := range resources {
+		h := p.handler(kind(resource))
+		exists, err := h.find(ctx, p.client, p.translators[kind(resource)], resource, plan.dependencies)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find %s %s in Atlas: %w", kind(resource), resource.GetName(), err)
+		}
+
+		step := Step{Kind: kind(resource), Name: resource.GetName(), Action: ActionCreate, Call: h.createCall(resource), object: resource}
+		if exists {
+			step.Action = ActionUpdate
+			step.Call = h.updateCall(resource)
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	return plan, nil
+}
+
+// Apply runs the steps of a plan in order, stopping at the first failure.
+func (p *Pusher) Apply(ctx context.Context, plan *Plan) error {
+	for _, step := range plan.Steps {
+		h := p.handler(step.Kind)
+		translator := p.translators[step.Kind]
+
+		var err error
+		switch step.Action {
+		case ActionCreate:
+			err = h.create(ctx, p.client, translator, step.object, plan.dependencies)
+		case ActionUpdate:
+			err = h.update(ctx, p.client, translator, step.object, plan.dependencies)
+		case ActionSkip:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %s %s: %w", step.Action, step.Kind, step.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// handler returns the handler of a kind. Projects of AtlasProject resources are created in the organization of the pusher.
+func (p *Pusher) handler(kind string) handler {
+	if kind == "AtlasProject" {
+		return projectHandler{orgID: p.orgID}
+	}
+
+	return handlers[kind]
+}
+
+func skip(kind, name, reason string) Step {
+	return Step{Kind: kind, Name: name, Action: ActionSkip, Reason: reason}
+}
+
+func kind(obj client.Object) string {
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
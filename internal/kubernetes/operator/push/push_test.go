@@ -0,0 +1,421 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312018/admin"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const manifests = `apiVersion: atlas.generated.mongodb.com/v1
+kind: DatabaseUser
+metadata:
+  name: alice
+spec:
+  v20250312:
+    groupRef:
+      name: my-group
+    entry:
+      databaseName: admin
+      username: alice
+      passwordSecretRef:
+        name: alice-password
+      roles:
+      - databaseName: admin
+        roleName: readAnyDatabase
+---
+apiVersion: atlas.generated.mongodb.com/v1
+kind: Group
+metadata:
+  name: my-group
+  annotations:
+    mongodb.com/external-id: 64b7d9b5e8a4c27d1a2b3c4d
+spec:
+  v20250312:
+    entry:
+      name: my-project
+      orgId: 64b7d9b5e8a4c27d1a2b3c00
+---
+apiVersion: atlas.generated.mongodb.com/v1
+kind: FlexCluster
+metadata:
+  name: my-flex
+spec:
+  v20250312:
+    groupRef:
+      name: my-group
+    entry:
+      name: flex
+      providerSettings:
+        backingProviderName: AWS
+        regionName: US_EAST_1
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: alice-password
+data:
+  password: c2VjcmV0
+---
+apiVersion: atlas.mongodb.com/v1
+kind: AtlasTeam
+metadata:
+  name: my-team
+spec:
+  name: my-team
+`
+
+const curatedManifests = `apiVersion: atlas.mongodb.com/v1
+kind: AtlasDeployment
+metadata:
+  name: my-cluster
+  namespace: apps
+spec:
+  projectRef:
+    name: my-project
+  deploymentSpec:
+    name: cluster0
+    clusterType: REPLICASET
+    diskSizeGB: 20
+    replicationSpecs:
+    - zoneName: Zone 1
+      regionConfigs:
+      - providerName: AWS
+        regionName: US_EAST_1
+        priority: 7
+        electableSpecs:
+          instanceSize: M10
+          nodeCount: 3
+---
+apiVersion: atlas.mongodb.com/v1
+kind: AtlasDeployment
+metadata:
+  name: my-serverless
+  namespace: apps
+spec:
+  projectRef:
+    name: my-project
+  serverlessSpec:
+    name: serverless0
+    providerSettings:
+      providerName: SERVERLESS
+---
+apiVersion: atlas.mongodb.com/v1
+kind: AtlasIPAccessList
+metadata:
+  name: my-access-list
+  namespace: apps
+spec:
+  projectRef:
+    name: my-project
+  entries:
+  - cidrBlock: 10.0.0.0/24
+    comment: office
+---
+apiVersion: atlas.mongodb.com/v1
+kind: AtlasDatabaseUser
+metadata:
+  name: bob
+  namespace: apps
+spec:
+  projectRef:
+    name: my-project
+  username: bob
+  databaseName: admin
+  passwordSecretRef:
+    name: bob-password
+  roles:
+  - roleName: readWriteAnyDatabase
+    databaseName: admin
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: bob-password
+  namespace: apps
+stringData:
+  password: hunter2
+---
+apiVersion: atlas.mongodb.com/v1
+kind: AtlasProject
+metadata:
+  name: my-project
+  namespace: apps
+spec:
+  name: my-project
+`
+
+// fakeAtlas serves the given responses, keyed by method and path, and answers 404 to any other request.
+type fakeAtlas struct {
+	mu        sync.Mutex
+	responses map[string]string
+	calls     []string
+	bodies    map[string]map[string]any
+}
+
+func (f *fakeAtlas) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	call := r.Method + " " + r.URL.Path
+	f.calls = append(f.calls, call)
+	if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+		body := map[string]any{}
+		_ = json.Unmarshal(data, &body)
+		f.bodies[call] = body
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	response, ok := f.responses[call]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":404,"errorCode":"RESOURCE_NOT_FOUND"}`))
+		return
+	}
+	_, _ = w.Write([]byte(response))
+}
+
+func newTestPusher(t *testing.T, responses map[string]string) (*Pusher, *fakeAtlas) {
+	t.Helper()
+
+	atlas := &fakeAtlas{responses: responses, bodies: map[string]map[string]any{}}
+	server := httptest.NewServer(atlas)
+	t.Cleanup(server.Close)
+
+	client, err := admin.NewClient(admin.UseBaseURL(server.URL), admin.UseHTTPClient(server.Client()))
+	require.NoError(t, err)
+
+	scheme, err := exporter.NewScheme()
+	require.NoError(t, err)
+
+	translators, err := exporter.NewTranslators(scheme, crds.NewBundledAtlasCRDProvider(nil), "2.14.0")
+	require.NoError(t, err)
+
+	return NewPusher(client, scheme, translators), atlas
+}
+
+func TestReadManifests(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "manifests/project/resources.yaml", []byte(manifests), 0600))
+	require.NoError(t, afero.WriteFile(fs, "manifests/kustomization.yml", []byte("---\nresources: []\n"), 0600))
+	require.NoError(t, afero.WriteFile(fs, "manifests/README.md", []byte("# not a manifest"), 0600))
+
+	objects, err := ReadManifests(fs, "manifests")
+	require.NoError(t, err)
+	assert.Len(t, objects, 6)
+
+	objects, err = ReadManifests(fs, "manifests/project/resources.yaml")
+	require.NoError(t, err)
+	assert.Len(t, objects, 5)
+
+	_, err = ReadManifests(fs, "missing")
+	require.Error(t, err)
+}
+
+func TestPusher_CreatesGroupAndDependents(t *testing.T) {
+	pusher, atlas := newTestPusher(t, map[string]string{
+		"POST /api/atlas/v2/groups":                                        `{"id":"64b7d9b5e8a4c27d1a2b3cff","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":0,"created":"2025-01-01T00:00:00Z"}`,
+		"POST /api/atlas/v2/groups/64b7d9b5e8a4c27d1a2b3cff/flexClusters":  `{"name":"flex"}`,
+		"POST /api/atlas/v2/groups/64b7d9b5e8a4c27d1a2b3cff/databaseUsers": `{"username":"alice","databaseName":"admin","groupId":"64b7d9b5e8a4c27d1a2b3cff"}`,
+	})
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "resources.yaml", []byte(manifests), 0600))
+	objects, err := ReadManifests(fs, "resources.yaml")
+	require.NoError(t, err)
+
+	plan, err := pusher.Plan(context.Background(), objects)
+	require.NoError(t, err)
+
+	steps := make([][3]string, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		steps = append(steps, [3]string{step.Kind, step.Name, string(step.Action)})
+	}
+	assert.Equal(t, [][3]string{
+		{"AtlasTeam", "my-team", "skip"},
+		{"Group", "my-group", "create"},
+		{"FlexCluster", "my-flex", "create"},
+		{"DatabaseUser", "alice", "create"},
+	}, steps)
+	// dependents of a group to be created are not looked up in Atlas
+	assert.Equal(t, []string{
+		"GET /api/atlas/v2/groups/64b7d9b5e8a4c27d1a2b3c4d",
+		"GET /api/atlas/v2/groups/byName/my-project",
+	}, atlas.calls)
+
+	require.NoError(t, pusher.Apply(context.Background(), plan))
+	assert.Equal(t, []string{
+		"POST /api/atlas/v2/groups",
+		"POST /api/atlas/v2/groups/64b7d9b5e8a4c27d1a2b3cff/flexClusters",
+		"POST /api/atlas/v2/groups/64b7d9b5e8a4c27d1a2b3cff/databaseUsers",
+	}, atlas.calls[2:])
+	assert.Equal(t, "secret", atlas.bodies["POST /api/atlas/v2/groups/64b7d9b5e8a4c27d1a2b3cff/databaseUsers"]["password"])
+}
+
+func TestPusher_UpdatesExistingResources(t *testing.T) {
+	const groupID = "64b7d9b5e8a4c27d1a2b3c4d"
+	pusher, atlas := newTestPusher(t, map[string]string{
+		"GET /api/atlas/v2/groups/" + groupID:                                  `{"id":"` + groupID + `","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":1,"created":"2025-01-01T00:00:00Z"}`,
+		"GET /api/atlas/v2/groups/" + groupID + "/flexClusters/flex":           `{"name":"flex"}`,
+		"GET /api/atlas/v2/groups/" + groupID + "/databaseUsers/admin/alice":   `{"username":"alice","databaseName":"admin","groupId":"` + groupID + `"}`,
+		"PATCH /api/atlas/v2/groups/" + groupID:                                `{"id":"` + groupID + `","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":1,"created":"2025-01-01T00:00:00Z"}`,
+		"PATCH /api/atlas/v2/groups/" + groupID + "/flexClusters/flex":         `{"name":"flex"}`,
+		"PATCH /api/atlas/v2/groups/" + groupID + "/databaseUsers/admin/alice": `{"username":"alice","databaseName":"admin","groupId":"` + groupID + `"}`,
+	})
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "resources.yaml", []byte(manifests), 0600))
+	objects, err := ReadManifests(fs, "resources.yaml")
+	require.NoError(t, err)
+
+	plan, err := pusher.Plan(context.Background(), objects)
+	require.NoError(t, err)
+	for _, step := range plan.Steps[1:] {
+		assert.Equal(t, ActionUpdate, step.Action, step.Kind)
+	}
+
+	require.NoError(t, pusher.Apply(context.Background(), plan))
+	assert.Equal(t, []string{
+		"PATCH /api/atlas/v2/groups/" + groupID,
+		"PATCH /api/atlas/v2/groups/" + groupID + "/flexClusters/flex",
+		"PATCH /api/atlas/v2/groups/" + groupID + "/databaseUsers/admin/alice",
+	}, atlas.calls[3:])
+	assert.NotContains(t, atlas.bodies["PATCH /api/atlas/v2/groups/"+groupID], "orgId")
+}
+
+func TestPusher_PushesCuratedResources(t *testing.T) {
+	const groupID = "64b7d9b5e8a4c27d1a2b3cff"
+	pusher, atlas := newTestPusher(t, map[string]string{
+		"POST /api/atlas/v2/groups":                               `{"id":"` + groupID + `","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":0,"created":"2025-01-01T00:00:00Z"}`,
+		"POST /api/atlas/v2/groups/" + groupID + "/clusters":      `{"name":"cluster0"}`,
+		"POST /api/atlas/v2/groups/" + groupID + "/databaseUsers": `{"username":"bob","databaseName":"admin","groupId":"` + groupID + `"}`,
+		"POST /api/atlas/v2/groups/" + groupID + "/accessList":    `{"results":[],"totalCount":0}`,
+	})
+	pusher.WithOrgID("64b7d9b5e8a4c27d1a2b3c00")
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "resources.yaml", []byte(curatedManifests), 0600))
+	objects, err := ReadManifests(fs, "resources.yaml")
+	require.NoError(t, err)
+
+	plan, err := pusher.Plan(context.Background(), objects)
+	require.NoError(t, err)
+
+	steps := make([][4]string, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		steps = append(steps, [4]string{step.Kind, step.Name, string(step.Action), step.Call})
+	}
+	assert.Equal(t, [][4]string{
+		{"AtlasDeployment", "my-serverless", "skip", ""},
+		{"AtlasProject", "my-project", "create", "POST /api/atlas/v2/groups"},
+		{"AtlasDeployment", "my-cluster", "create", "POST /api/atlas/v2/groups/{groupId}/clusters"},
+		{"AtlasDatabaseUser", "bob", "create", "POST /api/atlas/v2/groups/{groupId}/databaseUsers"},
+		{"AtlasIPAccessList", "my-access-list", "create", "POST /api/atlas/v2/groups/{groupId}/accessList"},
+	}, steps)
+	assert.Equal(t, []string{"GET /api/atlas/v2/groups/byName/my-project"}, atlas.calls)
+
+	require.NoError(t, pusher.Apply(context.Background(), plan))
+	assert.Equal(t, []string{
+		"POST /api/atlas/v2/groups",
+		"POST /api/atlas/v2/groups/" + groupID + "/clusters",
+		"POST /api/atlas/v2/groups/" + groupID + "/databaseUsers",
+		"POST /api/atlas/v2/groups/" + groupID + "/accessList",
+	}, atlas.calls[1:])
+
+	assert.Equal(t, "64b7d9b5e8a4c27d1a2b3c00", atlas.bodies["POST /api/atlas/v2/groups"]["orgId"])
+	cluster := atlas.bodies["POST /api/atlas/v2/groups/"+groupID+"/clusters"]
+	assert.Equal(t, "cluster0", cluster["name"])
+	regionConfig := cluster["replicationSpecs"].([]any)[0].(map[string]any)["regionConfigs"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"instanceSize": "M10", "nodeCount": float64(3), "diskSizeGB": float64(20)}, regionConfig["electableSpecs"])
+	user := atlas.bodies["POST /api/atlas/v2/groups/"+groupID+"/databaseUsers"]
+	assert.Equal(t, "hunter2", user["password"])
+	assert.Equal(t, groupID, user["groupId"])
+}
+
+func TestPusher_CuratedProjectRequiresOrgID(t *testing.T) {
+	pusher, _ := newTestPusher(t, map[string]string{})
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "resources.yaml", []byte(curatedManifests), 0600))
+	objects, err := ReadManifests(fs, "resources.yaml")
+	require.NoError(t, err)
+
+	plan, err := pusher.Plan(context.Background(), objects)
+	require.NoError(t, err)
+	require.EqualError(t, pusher.Apply(context.Background(), plan), "failed to create AtlasProject my-project: an organization ID is required to create a project")
+}
+
+func TestPusher_CuratedDatabaseUserWithEmptyPassword(t *testing.T) {
+	const groupID = "64b7d9b5e8a4c27d1a2b3cff"
+	// a default export empties the values of the password secrets
+	emptyPassword := strings.Replace(curatedManifests, "password: hunter2", `password: ""`, 1)
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "resources.yaml", []byte(emptyPassword), 0600))
+
+	t.Run("refuses to create the user", func(t *testing.T) {
+		pusher, atlas := newTestPusher(t, map[string]string{
+			"POST /api/atlas/v2/groups":                            `{"id":"` + groupID + `","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":0,"created":"2025-01-01T00:00:00Z"}`,
+			"POST /api/atlas/v2/groups/" + groupID + "/clusters":   `{"name":"cluster0"}`,
+			"POST /api/atlas/v2/groups/" + groupID + "/accessList": `{"results":[],"totalCount":0}`,
+		})
+		pusher.WithOrgID("64b7d9b5e8a4c27d1a2b3c00")
+
+		objects, err := ReadManifests(fs, "resources.yaml")
+		require.NoError(t, err)
+
+		plan, err := pusher.Plan(context.Background(), objects)
+		require.NoError(t, err)
+		require.EqualError(t, pusher.Apply(context.Background(), plan), "failed to create AtlasDatabaseUser bob: password secret bob-password is empty")
+		assert.NotContains(t, atlas.calls, "POST /api/atlas/v2/groups/"+groupID+"/databaseUsers")
+	})
+
+	t.Run("keeps the password of an existing user", func(t *testing.T) {
+		pusher, atlas := newTestPusher(t, map[string]string{
+			"GET /api/atlas/v2/groups/byName/my-project":                         `{"id":"` + groupID + `","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":1,"created":"2025-01-01T00:00:00Z"}`,
+			"PATCH /api/atlas/v2/groups/" + groupID:                              `{"id":"` + groupID + `","name":"my-project","orgId":"64b7d9b5e8a4c27d1a2b3c00","clusterCount":1,"created":"2025-01-01T00:00:00Z"}`,
+			"GET /api/atlas/v2/groups/" + groupID + "/databaseUsers/admin/bob":   `{"username":"bob","databaseName":"admin","groupId":"` + groupID + `"}`,
+			"PATCH /api/atlas/v2/groups/" + groupID + "/databaseUsers/admin/bob": `{"username":"bob","databaseName":"admin","groupId":"` + groupID + `"}`,
+		})
+
+		objects, err := ReadManifests(fs, "resources.yaml")
+		require.NoError(t, err)
+		objects = slices.DeleteFunc(objects, func(obj *unstructured.Unstructured) bool {
+			return obj.GetKind() == "AtlasDeployment" || obj.GetKind() == "AtlasIPAccessList"
+		})
+
+		plan, err := pusher.Plan(context.Background(), objects)
+		require.NoError(t, err)
+		require.NoError(t, pusher.Apply(context.Background(), plan))
+		assert.Contains(t, atlas.calls, "PATCH /api/atlas/v2/groups/"+groupID+"/databaseUsers/admin/bob")
+		assert.NotContains(t, atlas.bodies["PATCH /api/atlas/v2/groups/"+groupID+"/databaseUsers/admin/bob"], "password")
+	})
+}
@@ -15,11 +15,15 @@
 package resources
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+const hashSuffixLength = 8
+
 func AtlasNameToKubernetesName() map[string]string {
 	return map[string]string{
 		" ": "-",
@@ -70,3 +74,22 @@ func NormalizeAtlasName(name string, dictionary map[string]string) string {
 
 	return strings.ToLower(name)
 }
+
+// HashSuffix returns a short suffix derived from the given Atlas identifier. Unlike a random
+// suffix it is the same on every export, so generated names stay stable across runs.
+func HashSuffix(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])[:hashSuffixLength]
+}
+
+// AppendHashSuffix appends the HashSuffix of the given Atlas identifier to an already normalized
+// name, shortening the name if needed so the result is still a valid Kubernetes name.
+func AppendHashSuffix(name, id string) string {
+	suffix := HashSuffix(id)
+	maxNameLength := validation.DNS1123LabelMaxLength - len(suffix) - 1
+	if len(name) > maxNameLength {
+		name = strings.TrimSuffix(name[:maxNameLength], "-")
+	}
+
+	return name + "-" + suffix
+}
@@ -18,6 +18,7 @@ package resources
 
 import (
 	"regexp"
+	"strings"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -63,6 +64,27 @@ func TestNormalizeAtlasName(t *testing.T) {
 	}
 }
 
+func TestAppendHashSuffix(t *testing.T) {
+	d := AtlasNameToKubernetesName()
+
+	t.Run("should be deterministic and depend on the id", func(t *testing.T) {
+		if got := AppendHashSuffix("project-user", "admin:user"); got != AppendHashSuffix("project-user", "admin:user") {
+			t.Errorf("AppendHashSuffix() is not deterministic, got %v", got)
+		}
+		if AppendHashSuffix("project-user", "admin:user") == AppendHashSuffix("project-user", "admin:User") {
+			t.Error("AppendHashSuffix() should differ for different ids")
+		}
+	})
+
+	t.Run("should keep long names DNS-1123 compliant", func(t *testing.T) {
+		name := NormalizeAtlasName(strings.Repeat("a", 62)+"-b", d)
+		got := AppendHashSuffix(name, "id")
+		if errs := validation.IsDNS1123Label(got); len(errs) > 0 {
+			t.Errorf("output should be DNS-1123 compliant, got:%s. errors: %v", got, errs)
+		}
+	})
+}
+
 func FuzzNormalizeAtlasName(f *testing.F) {
 	f.Fuzz(func(t *testing.T, input string) {
 		d := AtlasNameToKubernetesName()
@@ -0,0 +1,270 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	BackendExternalSecrets = "external-secrets"
+	BackendSealedSecrets   = "sealed-secrets"
+
+	DefaultExternalSecretsPath = "{{ .Namespace }}/{{ .Name }}"
+
+	externalSecretAPIVersion = "external-secrets.io/v1"
+	externalSecretStoreKind  = "ClusterSecretStore"
+	sealedSecretAPIVersion   = "bitnami.com/v1alpha1"
+	sealedSecretSessionKey   = 32
+)
+
+// Backends are the secret stores exported Secrets can be replaced with.
+var Backends = []string{BackendExternalSecrets, BackendSealedSecrets}
+
+// Converter replaces a Secret with a resource of a secret store, which can be committed to git.
+type Converter interface {
+	Convert(secret *corev1.Secret) (runtime.Object, error)
+}
+
+// ExternalSecretConverter replaces Secrets with ExternalSecrets of External Secrets Operator,
+// which read every key of the Secret from a path of a ClusterSecretStore.
+type ExternalSecretConverter struct {
+	store string
+	path  *template.Template
+}
+
+// NewExternalSecretConverter returns a converter reading secrets from the given store. The path template is
+// rendered with the Namespace and Name of each Secret.
+func NewExternalSecretConverter(store, pathTemplate string) (*ExternalSecretConverter, error) {
+	path, err := template.New("path").Option("missingkey=error").Parse(pathTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secrets path template %q: %w", pathTemplate, err)
+	}
+
+	return &ExternalSecretConverter{
+		store: store,
+		path:  path,
+	}, nil
+}
+
+func (c *ExternalSecretConverter) Convert(secret *corev1.Secret) (runtime.Object, error) {
+	path := &bytes.Buffer{}
+	if err := c.path.Execute(path, map[string]string{"Namespace": secret.Namespace, "Name": secret.Name}); err != nil {
+		return nil, fmt.Errorf("failed to render the secrets path of secret %s: %w", secret.Name, err)
+	}
+
+	keys := secretData(secret)
+	data := make([]any, 0, len(keys))
+	for _, key := range slices.Sorted(maps.Keys(keys)) {
+		data = append(data, map[string]any{
+			"secretKey": key,
+			"remoteRef": map[string]any{
+				"key":      path.String(),
+				"property": key,
+			},
+		})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": externalSecretAPIVersion,
+		"kind":       "ExternalSecret",
+		"metadata":   objectMetadata(secret),
+		"spec": map[string]any{
+			"secretStoreRef": map[string]any{
+				"kind": externalSecretStoreKind,
+				"name": c.store,
+			},
+			"target": map[string]any{
+				"name":           secret.Name,
+				"creationPolicy": "Owner",
+				"template": map[string]any{
+					"metadata": map[string]any{"labels": stringMap(secret.Labels)},
+				},
+			},
+			"data": data,
+		},
+	}}, nil
+}
+
+// SealedSecretConverter replaces Secrets with SealedSecrets of Bitnami Sealed Secrets, encrypted with
+// the public key of the controller. Secrets are sealed with the strict scope, so a SealedSecret can only
+// be decrypted with the name and namespace it was sealed with.
+type SealedSecretConverter struct {
+	publicKey *rsa.PublicKey
+	random    io.Reader
+}
+
+// NewSealedSecretConverter returns a converter encrypting secrets with the PEM encoded certificate or
+// public key of the Sealed Secrets controller, as fetched with kubeseal --fetch-cert.
+func NewSealedSecretConverter(publicKeyPEM []byte) (*SealedSecretConverter, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode the sealed secrets public key: no PEM data found")
+	}
+
+	var publicKey any
+	switch block.Type {
+	case "CERTIFICATE":
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the sealed secrets certificate: %w", err)
+		}
+		publicKey = certificate.PublicKey
+	default:
+		var err error
+		if publicKey, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to parse the sealed secrets public key: %w", err)
+		}
+	}
+
+	rsaKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("the sealed secrets public key is not an RSA key")
+	}
+
+	return &SealedSecretConverter{
+		publicKey: rsaKey,
+		random:    rand.Reader,
+	}, nil
+}
+
+func (c *SealedSecretConverter) Convert(secret *corev1.Secret) (runtime.Object, error) {
+	label := []byte(secret.Namespace + "/" + secret.Name)
+
+	data := secretData(secret)
+	encrypted := make(map[string]any, len(data))
+	for key, value := range data {
+		ciphertext, err := c.encrypt(value, label)
+		if err != nil {
+			return nil, fmt.Errorf("failed to seal key %s of secret %s: %w", key, secret.Name, err)
+		}
+		encrypted[key] = base64.StdEncoding.EncodeToString(ciphertext)
+	}
+
+	templateSpec := map[string]any{"metadata": objectMetadata(secret)}
+	if secret.Type != "" {
+		templateSpec["type"] = string(secret.Type)
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": sealedSecretAPIVersion,
+		"kind":       "SealedSecret",
+		"metadata":   objectMetadata(secret),
+		"spec": map[string]any{
+			"encryptedData": encrypted,
+			"template":      templateSpec,
+		},
+	}}, nil
+}
+
+// encrypt seals a value the way kubeseal does: a random AES-GCM session key encrypts the value,
+// and is itself encrypted with RSA-OAEP, labelled with the scope of the secret.
+func (c *SealedSecretConverter) encrypt(plaintext, label []byte) ([]byte, error) {
+	sessionKey := make([]byte, sealedSecretSessionKey)
+	if _, err := io.ReadFull(c.random, sessionKey); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), c.random, c.publicKey, sessionKey, label)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := binary.BigEndian.AppendUint16(nil, uint16(len(encryptedKey))) //nolint:gosec // RSA ciphertexts are at most a few KB
+	ciphertext = append(ciphertext, encryptedKey...)
+
+	// the session key is used once, so a zero nonce is safe
+	return aead.Seal(ciphertext, make([]byte, aead.NonceSize()), plaintext, nil), nil
+}
+
+// ConvertSecrets replaces every Secret of the objects using the converter, keeping their order.
+func ConvertSecrets(objects []runtime.Object, converter Converter) ([]runtime.Object, error) {
+	result := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			result = append(result, obj)
+			continue
+		}
+
+		converted, err := converter.Convert(secret)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+func secretData(secret *corev1.Secret) map[string][]byte {
+	data := make(map[string][]byte, len(secret.Data)+len(secret.StringData))
+	maps.Copy(data, secret.Data)
+	for key, value := range secret.StringData {
+		data[key] = []byte(value)
+	}
+
+	return data
+}
+
+func objectMetadata(secret *corev1.Secret) map[string]any {
+	metadata := map[string]any{"name": secret.Name}
+	if secret.Namespace != "" {
+		metadata["namespace"] = secret.Namespace
+	}
+	if len(secret.Labels) > 0 {
+		metadata["labels"] = stringMap(secret.Labels)
+	}
+
+	return metadata
+}
+
+// stringMap converts labels to the type unstructured objects hold, so they can be deep copied.
+func stringMap(values map[string]string) map[string]any {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+
+	return result
+}
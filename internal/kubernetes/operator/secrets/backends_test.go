@@ -0,0 +1,130 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func testSecret() *corev1.Secret {
+	return NewAtlasSecretBuilder("my-project-credentials", "prod", map[string]string{}).
+		WithData(map[string][]byte{CredPublicAPIKey: []byte("public"), CredPrivateAPIKey: []byte("private")}).
+		Build()
+}
+
+func TestConvertSecrets_ExternalSecrets(t *testing.T) {
+	converter, err := NewExternalSecretConverter("vault", "atlas/{{ .Namespace }}/{{ .Name }}")
+	require.NoError(t, err)
+
+	project := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "not-a-secret"}}
+	objects, err := ConvertSecrets([]runtime.Object{project, testSecret()}, converter)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Same(t, project, objects[0])
+
+	externalSecret := objects[1].(*unstructured.Unstructured)
+	assert.Equal(t, "ExternalSecret", externalSecret.GetKind())
+	assert.Equal(t, "prod", externalSecret.GetNamespace())
+	assert.Equal(t, "my-project-credentials", externalSecret.GetName())
+	assert.Equal(t, map[string]any{
+		"secretStoreRef": map[string]any{"kind": "ClusterSecretStore", "name": "vault"},
+		"target": map[string]any{
+			"name":           "my-project-credentials",
+			"creationPolicy": "Owner",
+			"template": map[string]any{
+				"metadata": map[string]any{"labels": map[string]any{TypeLabelKey: CredLabelVal}},
+			},
+		},
+		"data": []any{
+			map[string]any{"secretKey": CredPrivateAPIKey, "remoteRef": map[string]any{"key": "atlas/prod/my-project-credentials", "property": CredPrivateAPIKey}},
+			map[string]any{"secretKey": CredPublicAPIKey, "remoteRef": map[string]any{"key": "atlas/prod/my-project-credentials", "property": CredPublicAPIKey}},
+		},
+	}, externalSecret.Object["spec"])
+	assert.NotPanics(t, func() { externalSecret.DeepCopy() })
+}
+
+func TestNewExternalSecretConverter_InvalidTemplate(t *testing.T) {
+	_, err := NewExternalSecretConverter("vault", "{{ .Namespace")
+	require.ErrorContains(t, err, `invalid secrets path template "{{ .Namespace"`)
+}
+
+func TestConvertSecrets_SealedSecrets(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	converter, err := NewSealedSecretConverter(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+	require.NoError(t, err)
+
+	objects, err := ConvertSecrets([]runtime.Object{testSecret()}, converter)
+	require.NoError(t, err)
+
+	sealedSecret := objects[0].(*unstructured.Unstructured)
+	assert.Equal(t, "SealedSecret", sealedSecret.GetKind())
+	assert.Equal(t, "bitnami.com/v1alpha1", sealedSecret.GetAPIVersion())
+	assert.Equal(t, map[string]string{TypeLabelKey: CredLabelVal}, sealedSecret.GetLabels())
+
+	encrypted, _, err := unstructured.NestedStringMap(sealedSecret.Object, "spec", "encryptedData")
+	require.NoError(t, err)
+	require.Len(t, encrypted, 2)
+	assert.Equal(t, "private", unseal(t, privateKey, encrypted[CredPrivateAPIKey], "prod/my-project-credentials"))
+	assert.Equal(t, "public", unseal(t, privateKey, encrypted[CredPublicAPIKey], "prod/my-project-credentials"))
+}
+
+func TestNewSealedSecretConverter_InvalidKey(t *testing.T) {
+	_, err := NewSealedSecretConverter([]byte("not a key"))
+	require.EqualError(t, err, "failed to decode the sealed secrets public key: no PEM data found")
+}
+
+// unseal decrypts a value the way the Sealed Secrets controller does.
+func unseal(t *testing.T, privateKey *rsa.PrivateKey, value, label string) string {
+	t.Helper()
+
+	ciphertext, err := base64.StdEncoding.DecodeString(value)
+	require.NoError(t, err)
+
+	keyLength := int(binary.BigEndian.Uint16(ciphertext))
+	sessionKey, err := rsa.DecryptOAEP(sha256.New(), nil, privateKey, ciphertext[2:2+keyLength], []byte(label))
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(sessionKey)
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext[2+keyLength:], nil)
+	require.NoError(t, err)
+
+	return string(plaintext)
+}
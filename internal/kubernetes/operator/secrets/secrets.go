@@ -15,7 +15,9 @@
 package secrets
 
 import (
+	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -33,8 +35,45 @@ const (
 	CredPrivateAPIKey    = "privateApiKey"
 	CredPublicAPIKey     = "publicApiKey"
 	CredOrgID            = "orgId"
+	CredClientID         = "clientId"
+	CredClientSecret     = "clientSecret"
 )
 
+// CredentialsData returns the data of an Atlas connection secret. It holds the client credentials
+// of a service account when the profile authenticates with one, and programmatic API keys otherwise.
+// Values are left empty unless includeCreds is set.
+func CredentialsData(creds store.CredentialsGetter, orgID string, includeCreds bool) map[string][]byte {
+	serviceAccount := creds != nil && creds.AuthType() == config.ServiceAccount
+
+	if !includeCreds || creds == nil {
+		if serviceAccount {
+			return map[string][]byte{
+				CredOrgID:        []byte(""),
+				CredClientID:     []byte(""),
+				CredClientSecret: []byte(""),
+			}
+		}
+		return map[string][]byte{
+			CredOrgID:         []byte(""),
+			CredPublicAPIKey:  []byte(""),
+			CredPrivateAPIKey: []byte(""),
+		}
+	}
+
+	if serviceAccount {
+		return map[string][]byte{
+			CredOrgID:        []byte(orgID),
+			CredClientID:     []byte(creds.ClientID()),
+			CredClientSecret: []byte(creds.ClientSecret()),
+		}
+	}
+	return map[string][]byte{
+		CredOrgID:         []byte(orgID),
+		CredPublicAPIKey:  []byte(creds.PublicAPIKey()),
+		CredPrivateAPIKey: []byte(creds.PrivateAPIKey()),
+	}
+}
+
 type AtlasSecretBuilder func() (*corev1.Secret, map[string]string)
 
 func NewAtlasSecretBuilder(name, namespace string, dictionary map[string]string) AtlasSecretBuilder {
@@ -64,6 +103,17 @@ func (a AtlasSecretBuilder) WithData(data map[string][]byte) AtlasSecretBuilder
 	}
 }
 
+// WithSecretsData keeps the values of the secret data only when enabled, as requested with --includeSecrets.
+func (a AtlasSecretBuilder) WithSecretsData(enabled bool) AtlasSecretBuilder {
+	return func() (*corev1.Secret, map[string]string) {
+		s, d := a()
+		if !enabled {
+			Redact(s)
+		}
+		return s, d
+	}
+}
+
 func (a AtlasSecretBuilder) WithProjectLabels(id, name string) AtlasSecretBuilder {
 	return func() (*corev1.Secret, map[string]string) {
 		s, d := a()
@@ -85,6 +135,16 @@ func (a AtlasSecretBuilder) WithNotifierLabels(id *string, typeName string) Atla
 	}
 }
 
+// Redact empties every value of a secret. Keys are kept, so the secret still tells which values to fill in.
+func Redact(secret *corev1.Secret) {
+	for key := range secret.Data {
+		secret.Data[key] = []byte("")
+	}
+	for key := range secret.StringData {
+		secret.StringData[key] = ""
+	}
+}
+
 func (a AtlasSecretBuilder) Build() *corev1.Secret {
 	secret, _ := a()
 	return secret
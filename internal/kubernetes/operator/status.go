@@ -0,0 +1,274 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/crds"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	CRDStatusMatch    = "match"
+	CRDStatusMismatch = "mismatch"
+	CRDStatusMissing  = "missing"
+	CRDStatusUnknown  = "unknown"
+)
+
+// Status of an operator installation and of the custom resources it manages.
+type Status struct {
+	Namespace                            string           `json:"namespace"`
+	Version                              string           `json:"version"`
+	WatchNamespaces                      []string         `json:"watchNamespaces,omitempty"`
+	ResourceDeletionProtectionEnabled    bool             `json:"resourceDeletionProtectionEnabled"`
+	SubResourceDeletionProtectionEnabled bool             `json:"subResourceDeletionProtectionEnabled"`
+	Pods                                 []PodStatus      `json:"pods"`
+	CRDs                                 []CRDStatus      `json:"crds"`
+	Resources                            []ResourceStatus `json:"resources"`
+}
+
+type PodStatus struct {
+	Name     string `json:"name"`
+	Phase    string `json:"phase"`
+	Ready    bool   `json:"ready"`
+	Restarts int32  `json:"restarts"`
+}
+
+// CRDStatus tells whether a CRD installed in the cluster is the one released with the operator version.
+type CRDStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// ResourceStatus is the Ready condition of a custom resource, along with the reason it is not ready.
+type ResourceStatus struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Ready     string `json:"ready"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type StatusReader struct {
+	kubectl     *kubernetes.KubeCtl
+	crdProvider crds.AtlasOperatorCRDProvider
+}
+
+// Read returns the status of the operator installed in the namespace,
+// or of the first operator found in the cluster when the namespace is empty.
+func (s *StatusReader) Read(ctx context.Context, namespace string) (*Status, error) {
+	var deployment *appsv1.Deployment
+	var err error
+	if namespace == "" {
+		deployment, err = s.kubectl.FindAtlasOperator(ctx)
+	} else {
+		deployment, err = findOperatorDeployment(ctx, s.kubectl, namespace)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := installedConfig(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		Namespace:                            config.Namespace,
+		Version:                              config.Version,
+		WatchNamespaces:                      config.Watch,
+		ResourceDeletionProtectionEnabled:    config.ResourceDeletionProtectionEnabled,
+		SubResourceDeletionProtectionEnabled: config.SubResourceDeletionProtectionEnabled,
+	}
+
+	if status.Pods, err = s.pods(ctx, deployment); err != nil {
+		return nil, err
+	}
+
+	installedCRDs, err := listAtlasCRDs(ctx, s.kubectl)
+	if err != nil {
+		return nil, err
+	}
+
+	status.CRDs = s.crds(installedCRDs, config.Version)
+
+	customResources, err := listCustomResources(ctx, s.kubectl, installedCRDs)
+	if err != nil {
+		return nil, err
+	}
+
+	status.Resources = make([]ResourceStatus, 0, len(customResources))
+	for _, resource := range customResources {
+		status.Resources = append(status.Resources, resourceStatus(resource))
+	}
+
+	return status, nil
+}
+
+func (s *StatusReader) pods(ctx context.Context, deployment *appsv1.Deployment) ([]PodStatus, error) {
+	selector := labels.SelectorFromSet(kubernetes.AtlasOperatorLabels)
+	if deployment.Spec.Selector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(deployment.Spec.Selector); err != nil {
+			return nil, fmt.Errorf("failed to read the pod selector of the operator: %w", err)
+		}
+	}
+
+	list := &corev1.PodList{}
+	if err := s.kubectl.List(ctx, list, client.InNamespace(deployment.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list the operator pods: %w", err)
+	}
+
+	pods := make([]PodStatus, 0, len(list.Items))
+	for _, pod := range list.Items {
+		status := PodStatus{Name: pod.Name, Phase: string(pod.Status.Phase)}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady {
+				status.Ready = condition.Status == corev1.ConditionTrue
+			}
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			status.Restarts += container.RestartCount
+		}
+		pods = append(pods, status)
+	}
+
+	return pods, nil
+}
+
+// crds compares the curated CRDs installed in the cluster with the ones released with the operator version.
+func (s *StatusReader) crds(installed []apiextensionsv1.CustomResourceDefinition, operatorVersion string) []CRDStatus {
+	byName := make(map[string]*apiextensionsv1.CustomResourceDefinition, len(installed))
+	for i := range installed {
+		byName[installed[i].Spec.Group+"_"+installed[i].Spec.Names.Plural] = &installed[i]
+	}
+
+	// an unparsable version has no released CRDs to compare with
+	if _, err := semver.NewVersion(operatorVersion); err != nil {
+		return nil
+	}
+
+	resourceNames, ok := features.GetResourcesForVersion(operatorVersion)
+	if !ok {
+		return nil
+	}
+
+	statuses := make([]CRDStatus, 0, len(resourceNames))
+	for _, resourceName := range resourceNames {
+		status := CRDStatus{Name: resourceName, Status: CRDStatusMatch}
+
+		if crd, found := byName[resourceName]; !found {
+			status.Status = CRDStatusMissing
+		} else if released, err := s.crdProvider.GetAtlasOperatorResource(resourceName, operatorVersion); err != nil {
+			status.Status = CRDStatusUnknown
+		} else if !sameSchemas(crd, released) {
+			status.Status = CRDStatusMismatch
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+func sameSchemas(installed, released *apiextensionsv1.CustomResourceDefinition) bool {
+	if len(installed.Spec.Versions) != len(released.Spec.Versions) {
+		return false
+	}
+
+	for i := range released.Spec.Versions {
+		if installed.Spec.Versions[i].Name != released.Spec.Versions[i].Name ||
+			!equality.Semantic.DeepEqual(installed.Spec.Versions[i].Schema, released.Spec.Versions[i].Schema) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceStatus reads the Ready condition of a custom resource. When it is not ready, the reason is taken from
+// the Ready condition or, as the curated CRDs do, from the first failing condition.
+func resourceStatus(resource *unstructured.Unstructured) ResourceStatus {
+	status := ResourceStatus{
+		Kind:      resource.GetKind(),
+		Namespace: resource.GetNamespace(),
+		Name:      resource.GetName(),
+		Ready:     string(metav1.ConditionUnknown),
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(resource.Object, "status", "conditions")
+
+	var failure string
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		conditionType, _ := condition["type"].(string)
+		conditionStatus, _ := condition["status"].(string)
+		reason := conditionReason(condition)
+
+		switch {
+		case conditionType == "Ready":
+			status.Ready = conditionStatus
+			if conditionStatus != string(metav1.ConditionTrue) && reason != "" {
+				status.Reason = reason
+			}
+		case conditionStatus == string(metav1.ConditionFalse) && failure == "":
+			failure = conditionType + ": " + reason
+		}
+	}
+
+	if status.Ready != string(metav1.ConditionTrue) && status.Reason == "" {
+		status.Reason = strings.TrimSuffix(failure, ": ")
+	}
+
+	return status
+}
+
+func conditionReason(condition map[string]any) string {
+	reason, _ := condition["reason"].(string)
+	message, _ := condition["message"].(string)
+
+	switch {
+	case reason != "" && message != "":
+		return reason + ": " + message
+	case message != "":
+		return message
+	default:
+		return reason
+	}
+}
+
+func NewStatusReader(kubectl *kubernetes.KubeCtl, crdProvider crds.AtlasOperatorCRDProvider) *StatusReader {
+	return &StatusReader{
+		kubectl:     kubectl,
+		crdProvider: crdProvider,
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
+	akoapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStatusReader_Read(t *testing.T) {
+	ctx := context.Background()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator-7d9f", Namespace: "atlas", Labels: kubernetes.AtlasOperatorLabels},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "manager", RestartCount: 2}},
+		},
+	}
+	ready := atlasProject("ready-project")
+	ready.Status.Conditions = []akoapi.Condition{{Type: akoapi.ReadyType, Status: corev1.ConditionTrue}}
+	failing := atlasProject("failing-project")
+	failing.Status.Conditions = []akoapi.Condition{
+		{Type: akoapi.ReadyType, Status: corev1.ConditionFalse},
+		{Type: akoapi.ProjectReadyType, Status: corev1.ConditionFalse, Reason: "ProjectNotCreatedInAtlas", Message: "not authorized"},
+	}
+
+	released := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1", Storage: true}},
+		},
+	}
+
+	t.Run("reports the operator, its CRDs and custom resources", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, pod, ready, failing)
+		crdProvider := mocks.NewMockAtlasOperatorCRDProvider(gomock.NewController(t))
+		crdProvider.EXPECT().GetAtlasOperatorResource("atlas.mongodb.com_atlasprojects", "2.13.0").Return(released, nil)
+
+		status, err := NewStatusReader(kubernetes.NewKubeCtlFromClient(k8sClient), crdProvider).Read(ctx, "atlas")
+		require.NoError(t, err)
+
+		assert.Equal(t, "atlas", status.Namespace)
+		assert.Equal(t, "2.13.0", status.Version)
+		assert.Equal(t, []string{"atlas", "apps"}, status.WatchNamespaces)
+		assert.False(t, status.ResourceDeletionProtectionEnabled)
+		assert.True(t, status.SubResourceDeletionProtectionEnabled)
+		assert.Equal(t, []PodStatus{{Name: "mongodb-atlas-operator-7d9f", Phase: "Running", Ready: true, Restarts: 2}}, status.Pods)
+
+		crdStatuses := map[string]string{}
+		for _, crd := range status.CRDs {
+			crdStatuses[crd.Name] = crd.Status
+		}
+		assert.Equal(t, CRDStatusMatch, crdStatuses["atlas.mongodb.com_atlasprojects"])
+		assert.Equal(t, CRDStatusMissing, crdStatuses["atlas.mongodb.com_atlasdeployments"])
+
+		assert.ElementsMatch(t, []ResourceStatus{
+			{Kind: "AtlasProject", Namespace: "apps", Name: "ready-project", Ready: "True"},
+			{Kind: "AtlasProject", Namespace: "apps", Name: "failing-project", Ready: "False", Reason: "ProjectReady: ProjectNotCreatedInAtlas: not authorized"},
+		}, status.Resources)
+	})
+
+	t.Run("reports CRDs which differ from the released ones", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t)
+		crdProvider := mocks.NewMockAtlasOperatorCRDProvider(gomock.NewController(t))
+		changed := released.DeepCopy()
+		changed.Spec.Versions[0].Name = "v2"
+		crdProvider.EXPECT().GetAtlasOperatorResource("atlas.mongodb.com_atlasprojects", "2.13.0").Return(changed, nil)
+
+		status, err := NewStatusReader(kubernetes.NewKubeCtlFromClient(k8sClient), crdProvider).Read(ctx, "atlas")
+		require.NoError(t, err)
+		assert.Contains(t, status.CRDs, CRDStatus{Name: "atlas.mongodb.com_atlasprojects", Status: CRDStatusMismatch})
+		assert.Empty(t, status.Resources)
+	})
+
+	t.Run("reports CRDs which can not be compared", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t)
+		crdProvider := mocks.NewMockAtlasOperatorCRDProvider(gomock.NewController(t))
+		crdProvider.EXPECT().GetAtlasOperatorResource("atlas.mongodb.com_atlasprojects", "2.13.0").Return(nil, errors.New("offline"))
+
+		status, err := NewStatusReader(kubernetes.NewKubeCtlFromClient(k8sClient), crdProvider).Read(ctx, "atlas")
+		require.NoError(t, err)
+		assert.Contains(t, status.CRDs, CRDStatus{Name: "atlas.mongodb.com_atlasprojects", Status: CRDStatusUnknown})
+	})
+
+	t.Run("fails when no operator is installed in the namespace", func(t *testing.T) {
+		_, err := NewStatusReader(kubernetes.NewKubeCtlFromClient(newInstalledOperator(t)), nil).Read(ctx, "other")
+		require.EqualError(t, err, "couldn't find an operator installed in namespace other")
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/resources"
 	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
 	akoapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	akov2common "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
@@ -36,12 +37,19 @@ const (
 	connectionTypeKafka   = "Kafka"
 )
 
+// ErrUnsupportedConnectionType is returned for the connection types AtlasStreamConnection can not represent,
+// such as Https, AWSLambda or S3.
+var ErrUnsupportedConnectionType = errors.New("trying to generate an unsupported connection type")
+
+// BuildAtlasStreamsProcessing converts a stream instance and its connections. Connections of a type the CRD can not
+// represent are skipped with a warning, and left out of the connection registry of the instance.
 func BuildAtlasStreamsProcessing(
 	targetNamespace,
 	operatorVersion,
 	projectName string,
 	instance *admin.StreamsTenant,
 	connections []admin.StreamsConnection,
+	includeSecrets bool,
 	dictionary map[string]string,
 ) (*akov2.AtlasStreamInstance, []*akov2.AtlasStreamConnection, []*corev1.Secret, error) {
 	akoConnections := make([]*akov2.AtlasStreamConnection, 0, len(connections))
@@ -53,8 +61,13 @@ func BuildAtlasStreamsProcessing(
 			projectName,
 			instance.GetName(),
 			&connections[i],
+			includeSecrets,
 			dictionary,
 		)
+		if errors.Is(err, ErrUnsupportedConnectionType) {
+			_, _ = log.Warningf("Skipping connection %s of stream instance %s: %v\n", connections[i].GetName(), instance.GetName(), err)
+			continue
+		}
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -133,6 +146,7 @@ func buildAtlasStreamConnection(
 	projectName,
 	instanceName string,
 	connection *admin.StreamsConnection,
+	includeSecrets bool,
 	dictionary map[string]string,
 ) (*akov2.AtlasStreamConnection, []*corev1.Secret, error) {
 	switch connection.GetType() {
@@ -161,13 +175,14 @@ func buildAtlasStreamConnection(
 			projectName,
 			instanceName,
 			connection,
+			includeSecrets,
 			dictionary,
 		)
 
 		return resource, resourceSecrets, nil
 	}
 
-	return nil, nil, errors.New("trying to generate an unsupported connection type")
+	return nil, nil, fmt.Errorf("%w %s", ErrUnsupportedConnectionType, connection.GetType())
 }
 
 func buildAtlasStreamSampleConnection(
@@ -248,6 +263,7 @@ func buildAtlasStreamKafkaConnection(
 	projectName,
 	instanceName string,
 	connection *admin.StreamsConnection,
+	includeSecrets bool,
 	dictionary map[string]string,
 ) (*akov2.AtlasStreamConnection, []*corev1.Secret) {
 	authentication := connection.GetAuthentication()
@@ -256,6 +272,7 @@ func buildAtlasStreamKafkaConnection(
 	connSecrets := []*corev1.Secret{
 		secrets.NewAtlasSecretBuilder(fmt.Sprintf("%s-%s-%s-userpass", projectName, instanceName, connection.GetName()), targetNamespace, dictionary).
 			WithData(map[string][]byte{secrets.UsernameField: []byte(authentication.GetUsername()), secrets.PasswordField: []byte("")}).
+			WithSecretsData(includeSecrets).
 			Build(),
 	}
 
@@ -301,6 +318,7 @@ func buildAtlasStreamKafkaConnection(
 			connSecrets,
 			secrets.NewAtlasSecretBuilder(fmt.Sprintf("%s-%s-%s-certificate", projectName, instanceName, connection.GetName()), targetNamespace, dictionary).
 				WithData(map[string][]byte{secrets.CertificateField: []byte(security.GetBrokerPublicCertificate())}).
+				WithSecretsData(includeSecrets).
 				Build(),
 		)
 
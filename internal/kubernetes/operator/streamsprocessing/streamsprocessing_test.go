@@ -61,6 +61,7 @@ func TestBuildAtlasStreamKafkaConnection(t *testing.T) {
 			testProjectName,
 			testInstanceName,
 			&connection,
+			true,
 			map[string]string{},
 		)
 
@@ -142,6 +143,7 @@ func TestBuildAtlasStreamKafkaConnection(t *testing.T) {
 			testProjectName,
 			testInstanceName,
 			&connection,
+			true,
 			map[string]string{},
 		)
 
@@ -314,7 +316,7 @@ func TestBuildAtlasStreamConnection(t *testing.T) {
 			Type: pointer.Get("RabbitMQ"),
 		}
 
-		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, map[string]string{})
+		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, true, map[string]string{})
 		require.ErrorContains(t, err, "trying to generate an unsupported connection type")
 		assert.Nil(t, conn)
 		assert.Nil(t, sec)
@@ -348,7 +350,7 @@ func TestBuildAtlasStreamConnection(t *testing.T) {
 			},
 		}
 
-		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, map[string]string{})
+		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, true, map[string]string{})
 		require.NoError(t, err)
 		assert.Nil(t, sec)
 		assert.Equal(t, expectedResource, *conn)
@@ -394,7 +396,7 @@ func TestBuildAtlasStreamConnection(t *testing.T) {
 			},
 		}
 
-		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, map[string]string{})
+		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, true, map[string]string{})
 		require.NoError(t, err)
 		assert.Nil(t, sec)
 		assert.Equal(t, expectedResource, *conn)
@@ -487,7 +489,7 @@ func TestBuildAtlasStreamConnection(t *testing.T) {
 			},
 		}
 
-		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, map[string]string{})
+		conn, sec, err := buildAtlasStreamConnection(testNamespace, testOperatorVersion, testProjectName, testInstanceName, connection, true, map[string]string{})
 		require.NoError(t, err)
 		assert.Equal(t, expectedSecrets, sec)
 		assert.Equal(t, expectedResource, *conn)
@@ -761,6 +763,7 @@ func TestBuildAtlasStreamsProcessing(t *testing.T) {
 			testProjectName,
 			instance,
 			connections,
+			true,
 			map[string]string{},
 		)
 		require.NoError(t, err)
@@ -769,11 +772,19 @@ func TestBuildAtlasStreamsProcessing(t *testing.T) {
 		assert.Equal(t, expectedSecrets, secretsResource)
 	})
 
-	t.Run("should return error when unable to build stream processing resources", func(t *testing.T) {
+	t.Run("should skip connection types the CRD does not support", func(t *testing.T) {
 		connections := []admin.StreamsConnection{
 			{
-				Name: pointer.Get("rabbit-mq-config"),
-				Type: pointer.Get("RabbitMQ"),
+				Name: pointer.Get("https-config"),
+				Type: pointer.Get("Https"),
+			},
+			{
+				Name: pointer.Get("sample_stream_solar"),
+				Type: pointer.Get("Sample"),
+			},
+			{
+				Name: pointer.Get("lambda-config"),
+				Type: pointer.Get("AWSLambda"),
 			},
 		}
 		instance := &admin.StreamsTenant{
@@ -796,11 +807,17 @@ func TestBuildAtlasStreamsProcessing(t *testing.T) {
 			testProjectName,
 			instance,
 			connections,
+			true,
 			map[string]string{},
 		)
-		require.ErrorContains(t, err, "trying to generate an unsupported connection type")
-		assert.Nil(t, instanceResource)
-		assert.Nil(t, connectionsResources)
-		assert.Nil(t, secretsResource)
+		require.NoError(t, err)
+		require.Len(t, connectionsResources, 1)
+		assert.Equal(t, "sample_stream_solar", connectionsResources[0].Spec.Name)
+		assert.Equal(
+			t,
+			[]akov2common.ResourceRefNamespaced{{Name: connectionsResources[0].Name, Namespace: testNamespace}},
+			instanceResource.Spec.ConnectionRegistry,
+		)
+		assert.Empty(t, secretsResource)
 	})
 }
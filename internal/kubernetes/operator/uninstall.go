@@ -0,0 +1,438 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/secrets"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var errOperatorNotInstalled = errors.New("couldn't find an operator installed in namespace")
+
+// atlasCRDGroups are the API groups of the curated and the auto-generated CRDs.
+var atlasCRDGroups = []string{"atlas.mongodb.com", "atlas.generated.mongodb.com"}
+
+type Uninstall struct {
+	kubectl    *kubernetes.KubeCtl
+	atlasStore store.OperatorOrgStore
+
+	namespace         string
+	deleteCRDs        bool
+	deleteResources   bool
+	revokeCredentials bool
+	force             bool
+}
+
+func (u *Uninstall) WithDeleteCRDs(flag bool) *Uninstall {
+	u.deleteCRDs = flag
+
+	return u
+}
+
+func (u *Uninstall) WithDeleteResources(flag bool) *Uninstall {
+	u.deleteResources = flag
+
+	return u
+}
+
+func (u *Uninstall) WithRevokeCredentials(flag bool) *Uninstall {
+	u.revokeCredentials = flag
+
+	return u
+}
+
+func (u *Uninstall) WithForce(flag bool) *Uninstall {
+	u.force = flag
+
+	return u
+}
+
+// Run removes the operator installed in the namespace, along with its RBAC objects and credentials.
+// Custom resources are released before being deleted, so the Atlas resources they manage are left untouched.
+func (u *Uninstall) Run(ctx context.Context) error {
+	deployment, err := findOperatorDeployment(ctx, u.kubectl, u.namespace)
+	if err != nil {
+		return err
+	}
+
+	crds, err := listAtlasCRDs(ctx, u.kubectl)
+	if err != nil {
+		return err
+	}
+
+	customResources, err := listCustomResources(ctx, u.kubectl, crds)
+	if err != nil {
+		return err
+	}
+
+	// only the custom resources of the namespaces watched by the removed operator are deleted, unless
+	// the CRDs are, which deletes the custom resources of every namespace
+	released := customResources
+	if !u.deleteCRDs {
+		released = watchedResources(newInstallation(deployment), customResources)
+	}
+
+	if u.deleteCRDs && !u.force {
+		if err := u.checkSharedCRDs(ctx); err != nil {
+			return err
+		}
+
+		// deleting a CRD deletes its custom resources as well, so it is only done when asked to
+		if !u.deleteResources && len(customResources) > 0 {
+			return fmt.Errorf("%d custom resources still exist, delete them as well or force the deletion of the CRDs", len(customResources))
+		}
+	}
+
+	credentials, err := u.credentialsSecrets(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the credentials are revoked while their secrets still exist, so a failed revocation can be retried
+	if u.revokeCredentials {
+		for i := range credentials {
+			if err := u.revoke(&credentials[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the operator is stopped first, so it does not act on the deletion of custom resources
+	watched := u.namespace + "," + watchNamespace(deployment)
+	if err := u.delete(ctx, deployment); err != nil {
+		return err
+	}
+
+	if u.deleteResources || u.deleteCRDs {
+		for _, resource := range released {
+			if err := u.release(ctx, resource); err != nil {
+				return err
+			}
+		}
+	}
+
+	if u.deleteCRDs {
+		for i := range crds {
+			if err := u.delete(ctx, &crds[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := u.deleteRBAC(ctx, strings.Split(watched, ",")); err != nil {
+		return err
+	}
+
+	for i := range credentials {
+		if err := u.delete(ctx, &credentials[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listAtlasCRDs lists the curated and auto-generated CRDs installed in the cluster.
+func listAtlasCRDs(ctx context.Context, kubectl *kubernetes.KubeCtl) ([]apiextensionsv1.CustomResourceDefinition, error) {
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := kubectl.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("failed to list CRDs: %w", err)
+	}
+
+	crds := make([]apiextensionsv1.CustomResourceDefinition, 0, len(list.Items))
+	for _, crd := range list.Items {
+		if slices.Contains(atlasCRDGroups, crd.Spec.Group) {
+			crds = append(crds, crd)
+		}
+	}
+
+	return crds, nil
+}
+
+// listCustomResources lists the custom resources of the CRDs in every namespace.
+func listCustomResources(ctx context.Context, kubectl *kubernetes.KubeCtl, crds []apiextensionsv1.CustomResourceDefinition) ([]*unstructured.Unstructured, error) {
+	var resources []*unstructured.Unstructured
+	for _, crd := range crds {
+		for _, version := range crd.Spec.Versions {
+			if !version.Storage {
+				continue
+			}
+
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.ListKind})
+			if err := kubectl.List(ctx, list); err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", crd.Spec.Names.Plural, err)
+			}
+
+			for i := range list.Items {
+				resources = append(resources, &list.Items[i])
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// watchedResources returns the custom resources of the namespaces watched by an operator.
+func watchedResources(installation Installation, resources []*unstructured.Unstructured) []*unstructured.Unstructured {
+	watched := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, resource := range resources {
+		if installation.Watches(resource.GetNamespace()) {
+			watched = append(watched, resource)
+		}
+	}
+
+	return watched
+}
+
+// checkSharedCRDs fails when operators are installed in other namespaces, as they rely on the CRDs as well.
+func (u *Uninstall) checkSharedCRDs(ctx context.Context) error {
+	installations, err := FindInstallations(ctx, u.kubectl)
+	if err != nil {
+		return err
+	}
+
+	var others []string
+	for _, installation := range installations {
+		if installation.Namespace != u.namespace {
+			others = append(others, installation.Namespace)
+		}
+	}
+
+	if len(others) > 0 {
+		return fmt.Errorf("the CRDs are used by the operators installed in %s, force their deletion to delete them anyway", strings.Join(others, ", "))
+	}
+
+	return nil
+}
+
+// credentialsSecrets lists the secrets holding the credentials the operator was installed with.
+func (u *Uninstall) credentialsSecrets(ctx context.Context) ([]corev1.Secret, error) {
+	list := &corev1.SecretList{}
+	if err := u.kubectl.List(ctx, list, client.InNamespace(u.namespace), client.MatchingLabels{"atlas.mongodb.com/type": "credentials"}); err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	credentials := make([]corev1.Secret, 0, len(list.Items))
+	for _, secret := range list.Items {
+		if isOperatorCredentials(secret.Name) {
+			credentials = append(credentials, secret)
+		}
+	}
+
+	return credentials, nil
+}
+
+func isOperatorCredentials(name string) bool {
+	prefix, suffix, _ := strings.Cut(credentialsProjectScopedName, "%s")
+
+	return name == credentialsGlobalName || (strings.HasPrefix(name, prefix) && strings.HasSuffix(name, suffix))
+}
+
+// release removes the finalizers of a custom resource, so it can be deleted without an operator running, and deletes it.
+func (u *Uninstall) release(ctx context.Context, resource *unstructured.Unstructured) error {
+	if len(resource.GetFinalizers()) > 0 {
+		patch := client.MergeFrom(resource.DeepCopy())
+		resource.SetFinalizers(nil)
+		if err := u.kubectl.Patch(ctx, resource, patch); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to remove finalizers of %s %s: %w", resource.GetKind(), resource.GetName(), err)
+		}
+	}
+
+	return u.delete(ctx, resource)
+}
+
+// deleteRBAC removes the service account, roles and role bindings of the operator. Cluster roles
+// are only removed once no other installation binds them.
+func (u *Uninstall) deleteRBAC(ctx context.Context, namespaces []string) error {
+	serviceAccounts := &corev1.ServiceAccountList{}
+	if err := u.kubectl.List(ctx, serviceAccounts, client.InNamespace(u.namespace), client.MatchingLabels(kubernetes.AtlasOperatorLabels)); err != nil {
+		return fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	for i := range serviceAccounts.Items {
+		if err := u.delete(ctx, &serviceAccounts.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	slices.Sort(namespaces)
+	for _, namespace := range slices.Compact(namespaces) {
+		if namespace == "" {
+			continue
+		}
+
+		roleBindings := &rbacv1.RoleBindingList{}
+		if err := u.kubectl.List(ctx, roleBindings, client.InNamespace(namespace), client.MatchingLabels(kubernetes.AtlasOperatorLabels)); err != nil {
+			return fmt.Errorf("failed to list role bindings: %w", err)
+		}
+		for i := range roleBindings.Items {
+			if err := u.delete(ctx, &roleBindings.Items[i]); err != nil {
+				return err
+			}
+		}
+
+		roles := &rbacv1.RoleList{}
+		if err := u.kubectl.List(ctx, roles, client.InNamespace(namespace), client.MatchingLabels(kubernetes.AtlasOperatorLabels)); err != nil {
+			return fmt.Errorf("failed to list roles: %w", err)
+		}
+		for i := range roles.Items {
+			if err := u.delete(ctx, &roles.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	if err := u.kubectl.List(ctx, clusterRoleBindings, client.MatchingLabels(kubernetes.AtlasOperatorLabels)); err != nil {
+		return fmt.Errorf("failed to list cluster role bindings: %w", err)
+	}
+	boundClusterRoles := map[string]struct{}{}
+	for i := range clusterRoleBindings.Items {
+		binding := &clusterRoleBindings.Items[i]
+		if !bindsNamespace(binding.Subjects, u.namespace) {
+			boundClusterRoles[binding.RoleRef.Name] = struct{}{}
+			continue
+		}
+
+		if err := u.delete(ctx, binding); err != nil {
+			return err
+		}
+	}
+
+	clusterRoles := &rbacv1.ClusterRoleList{}
+	if err := u.kubectl.List(ctx, clusterRoles, client.MatchingLabels(kubernetes.AtlasOperatorLabels)); err != nil {
+		return fmt.Errorf("failed to list cluster roles: %w", err)
+	}
+	for i := range clusterRoles.Items {
+		if _, bound := boundClusterRoles[clusterRoles.Items[i].Name]; bound {
+			continue
+		}
+
+		if err := u.delete(ctx, &clusterRoles.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func bindsNamespace(subjects []rbacv1.Subject, namespace string) bool {
+	for _, subject := range subjects {
+		if subject.Namespace == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// revoke deletes from Atlas the API key or the service account of a credentials secret.
+func (u *Uninstall) revoke(secret *corev1.Secret) error {
+	orgID := string(secret.Data[secrets.CredOrgID])
+
+	if clientID := string(secret.Data[secrets.CredClientID]); clientID != "" {
+		if err := u.atlasStore.DeleteOrganizationServiceAccount(orgID, clientID); err != nil {
+			return fmt.Errorf("failed to revoke service account %s: %w", clientID, err)
+		}
+
+		return nil
+	}
+
+	publicKey := string(secret.Data[secrets.CredPublicAPIKey])
+	keys, err := u.atlasStore.AllOrganizationAPIKeys(orgID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key %s: %w", publicKey, err)
+	}
+
+	for _, key := range keys {
+		if key.GetPublicKey() != publicKey {
+			continue
+		}
+
+		if err := u.atlasStore.DeleteOrganizationAPIKey(orgID, key.GetId()); err != nil {
+			return fmt.Errorf("failed to revoke API key %s: %w", publicKey, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("couldn't find API key %s in organization %s", publicKey, orgID)
+}
+
+// findOperatorDeployment returns the deployment of the operator installed in the namespace.
+func findOperatorDeployment(ctx context.Context, kubectl *kubernetes.KubeCtl, namespace string) (*appsv1.Deployment, error) {
+	deployments := &appsv1.DeploymentList{}
+	if err := kubectl.List(ctx, deployments, client.InNamespace(namespace), client.MatchingLabels(kubernetes.AtlasOperatorLabels)); err != nil {
+		return nil, fmt.Errorf("failed to find the operator: %w", err)
+	}
+	if len(deployments.Items) == 0 {
+		return nil, fmt.Errorf("%w %s", errOperatorNotInstalled, namespace)
+	}
+
+	return &deployments.Items[0], nil
+}
+
+func (u *Uninstall) delete(ctx context.Context, obj client.Object) error {
+	if err := u.kubectl.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", kindOf(obj), obj.GetName(), err)
+	}
+
+	return nil
+}
+
+// kindOf returns the kind of an object, which typed objects read from the cluster only carry in their type.
+func kindOf(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
+
+// watchNamespace returns the namespaces watched by a namespaced operator.
+func watchNamespace(deployment *appsv1.Deployment) string {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == "WATCH_NAMESPACE" {
+				return env.Value
+			}
+		}
+	}
+
+	return ""
+}
+
+func NewUninstall(kubectl *kubernetes.KubeCtl, atlasStore store.OperatorOrgStore, namespace string) *Uninstall {
+	return &Uninstall{
+		kubectl:    kubectl,
+		atlasStore: atlasStore,
+		namespace:  namespace,
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newInstalledOperator(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(testScheme))
+	require.NoError(t, appsv1.AddToScheme(testScheme))
+	require.NoError(t, rbacv1.AddToScheme(testScheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(testScheme))
+	require.NoError(t, akov2.AddToScheme(testScheme))
+
+	operatorMeta := func(name, namespace string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: kubernetes.AtlasOperatorLabels}
+	}
+	subjects := []rbacv1.Subject{{Kind: "ServiceAccount", Name: "mongodb-atlas-operator", Namespace: "atlas"}}
+
+	installed := []client.Object{
+		&appsv1.Deployment{
+			ObjectMeta: operatorMeta("mongodb-atlas-operator", "atlas"),
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "manager",
+							Image: "mongodb/mongodb-atlas-kubernetes-operator:2.13.0",
+							Args:  []string{"--atlas-domain=https://cloud-qa.mongodb.com/", "--object-deletion-protection=false"},
+							Env:   []corev1.EnvVar{{Name: "WATCH_NAMESPACE", Value: "atlas,apps"}},
+						}},
+					},
+				},
+			},
+		},
+		&corev1.ServiceAccount{ObjectMeta: operatorMeta("mongodb-atlas-operator", "atlas")},
+		&rbacv1.Role{ObjectMeta: operatorMeta("mongodb-atlas-manager-role", "atlas")},
+		&rbacv1.Role{ObjectMeta: operatorMeta("mongodb-atlas-manager-role", "apps")},
+		&rbacv1.RoleBinding{ObjectMeta: operatorMeta("mongodb-atlas-manager-rolebinding", "apps"), Subjects: subjects},
+		&rbacv1.ClusterRole{ObjectMeta: operatorMeta("mongodb-atlas-metrics-reader", "")},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: operatorMeta("mongodb-atlas-metrics-rolebinding", ""),
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "mongodb-atlas-metrics-reader"},
+			Subjects:   subjects,
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: credentialsGlobalName, Namespace: "atlas", Labels: map[string]string{"atlas.mongodb.com/type": "credentials"}},
+			Data:       map[string][]byte{"orgId": []byte("org-id"), "publicApiKey": []byte("public-key"), "privateApiKey": []byte("private-key")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-project-credentials", Namespace: "atlas", Labels: map[string]string{"atlas.mongodb.com/type": "credentials"}},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group:    "atlas.mongodb.com",
+				Names:    apiextensionsv1.CustomResourceDefinitionNames{Kind: "AtlasProject", ListKind: "AtlasProjectList", Plural: "atlasprojects"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1", Storage: true}},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "certificates.cert-manager.io"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "cert-manager.io"},
+		},
+	}
+
+	return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(append(installed, objects...)...).Build()
+}
+
+func atlasProject(name string) *akov2.AtlasProject {
+	return &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps", Finalizers: []string{"mongodbatlas/finalizer"}},
+		Spec:       akov2.AtlasProjectSpec{Name: name},
+	}
+}
+
+func assertDeleted(t *testing.T, k8sClient client.Client, deleted bool, obj client.Object) {
+	t.Helper()
+
+	err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(obj), obj)
+	if deleted {
+		assert.True(t, apierrors.IsNotFound(err), "%T %s should be deleted", obj, obj.GetName())
+	} else {
+		assert.NoError(t, err, "%T %s should be kept", obj, obj.GetName())
+	}
+}
+
+func TestUninstall_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes the operator and keeps CRDs and custom resources by default", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, atlasProject("my-project"))
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, true, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, true, &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-manager-role", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, true, &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-manager-role", Namespace: "apps"}})
+		assertDeleted(t, k8sClient, true, &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-manager-rolebinding", Namespace: "apps"}})
+		assertDeleted(t, k8sClient, true, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-metrics-rolebinding"}})
+		assertDeleted(t, k8sClient, true, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-metrics-reader"}})
+		assertDeleted(t, k8sClient, true, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: credentialsGlobalName, Namespace: "atlas"}})
+
+		assertDeleted(t, k8sClient, false, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-project-credentials", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, false, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"}})
+		assertDeleted(t, k8sClient, false, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "apps"}})
+	})
+
+	t.Run("keeps cluster roles bound by another installation", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-metrics-rolebinding", Labels: kubernetes.AtlasOperatorLabels},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "mongodb-atlas-metrics-reader"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "mongodb-atlas-operator", Namespace: "other"}},
+		})
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-metrics-rolebinding"}})
+		assertDeleted(t, k8sClient, false, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "other-metrics-rolebinding"}})
+		assertDeleted(t, k8sClient, false, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-metrics-reader"}})
+	})
+
+	t.Run("refuses to delete CRDs while custom resources exist", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, atlasProject("my-project"))
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").
+			WithDeleteCRDs(true).
+			Run(ctx)
+		require.ErrorContains(t, err, "1 custom resources still exist")
+
+		assertDeleted(t, k8sClient, false, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, false, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"}})
+	})
+
+	t.Run("deletes CRDs and custom resources when forced", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, atlasProject("my-project"))
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").
+			WithDeleteCRDs(true).
+			WithForce(true).
+			Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "apps"}})
+		assertDeleted(t, k8sClient, true, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"}})
+		assertDeleted(t, k8sClient, false, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "certificates.cert-manager.io"}})
+	})
+
+	t.Run("deletes custom resources and keeps CRDs", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, atlasProject("my-project"))
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").
+			WithDeleteResources(true).
+			Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "apps"}})
+		assertDeleted(t, k8sClient, false, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"}})
+	})
+
+	t.Run("keeps the custom resources and CRDs of another operator", func(t *testing.T) {
+		otherOperator := func() []client.Object {
+			otherProject := atlasProject("other-project")
+			otherProject.Namespace = "tenant-b"
+
+			return []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "atlas"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b"}},
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "tenant-b", Labels: kubernetes.AtlasOperatorLabels},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{
+									Name:  "manager",
+									Image: "mongodb/mongodb-atlas-kubernetes-operator:2.13.0",
+									Env:   []corev1.EnvVar{{Name: "WATCH_NAMESPACE", Value: "tenant-b"}},
+								}},
+							},
+						},
+					},
+				},
+				atlasProject("my-project"),
+				otherProject,
+			}
+		}
+
+		k8sClient := newInstalledOperator(t, otherOperator()...)
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").
+			WithDeleteResources(true).
+			Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "apps"}})
+		assertDeleted(t, k8sClient, false, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "other-project", Namespace: "tenant-b"}})
+		assertDeleted(t, k8sClient, false, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "tenant-b"}})
+
+		k8sClient = newInstalledOperator(t, otherOperator()...)
+		err = NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").
+			WithDeleteCRDs(true).
+			WithDeleteResources(true).
+			Run(ctx)
+		require.ErrorContains(t, err, "the CRDs are used by the operators installed in tenant-b")
+
+		assertDeleted(t, k8sClient, false, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, false, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "apps"}})
+		assertDeleted(t, k8sClient, false, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"}})
+
+		err = NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "atlas").
+			WithDeleteCRDs(true).
+			WithForce(true).
+			Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "other-project", Namespace: "tenant-b"}})
+		assertDeleted(t, k8sClient, true, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"}})
+	})
+
+	t.Run("revokes the API key", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t)
+		storeMock := mocks.NewMockOperatorOrgStore(gomock.NewController(t))
+		storeMock.EXPECT().AllOrganizationAPIKeys("org-id").Return([]admin.ApiKeyUserDetails{
+			{Id: pointer.Get("other-key-id"), PublicKey: pointer.Get("other-key")},
+			{Id: pointer.Get("key-id"), PublicKey: pointer.Get("public-key")},
+		}, nil)
+		storeMock.EXPECT().DeleteOrganizationAPIKey("org-id", "key-id").Return(nil)
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), storeMock, "atlas").
+			WithRevokeCredentials(true).
+			Run(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("revokes the service account", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-my-project-api-key", Namespace: "atlas", Labels: map[string]string{"atlas.mongodb.com/type": "credentials"}},
+			Data:       map[string][]byte{"orgId": []byte("org-id"), "clientId": []byte("client-id"), "clientSecret": []byte("client-secret")},
+		})
+		storeMock := mocks.NewMockOperatorOrgStore(gomock.NewController(t))
+		storeMock.EXPECT().AllOrganizationAPIKeys("org-id").Return([]admin.ApiKeyUserDetails{
+			{Id: pointer.Get("key-id"), PublicKey: pointer.Get("public-key")},
+		}, nil)
+		storeMock.EXPECT().DeleteOrganizationAPIKey("org-id", "key-id").Return(nil)
+		storeMock.EXPECT().DeleteOrganizationServiceAccount("org-id", "client-id").Return(nil)
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), storeMock, "atlas").
+			WithRevokeCredentials(true).
+			Run(ctx)
+		require.NoError(t, err)
+
+		assertDeleted(t, k8sClient, true, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-my-project-api-key", Namespace: "atlas"}})
+	})
+
+	t.Run("keeps the operator and its credentials when revoking fails", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t)
+		storeMock := mocks.NewMockOperatorOrgStore(gomock.NewController(t))
+		storeMock.EXPECT().AllOrganizationAPIKeys("org-id").Return([]admin.ApiKeyUserDetails{
+			{Id: pointer.Get("key-id"), PublicKey: pointer.Get("public-key")},
+		}, nil)
+		storeMock.EXPECT().DeleteOrganizationAPIKey("org-id", "key-id").Return(errors.New("unauthorized"))
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), storeMock, "atlas").
+			WithRevokeCredentials(true).
+			Run(ctx)
+		require.EqualError(t, err, "failed to revoke API key public-key: unauthorized")
+
+		assertDeleted(t, k8sClient, false, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "atlas"}})
+		assertDeleted(t, k8sClient, false, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: credentialsGlobalName, Namespace: "atlas"}})
+	})
+
+	t.Run("fails when no operator is installed in the namespace", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t)
+
+		err := NewUninstall(kubernetes.NewKubeCtlFromClient(k8sClient), nil, "default").Run(ctx)
+		require.ErrorContains(t, err, "couldn't find an operator installed in namespace default")
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/version"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const (
+	operatorImage            = "mongodb/mongodb-atlas-kubernetes-operator"
+	curatedCRDGroup          = "atlas.mongodb.com"
+	atlasDomainArg           = "--atlas-domain="
+	deletionProtectionOff    = "--object-deletion-protection=false"
+	subDeletionProtectionOff = "--subobject-deletion-protection=false"
+)
+
+type Upgrade struct {
+	installResources Installer
+	versionProvider  version.AtlasOperatorVersionProvider
+	featureValidator features.FeatureValidator
+	kubectl          *kubernetes.KubeCtl
+
+	version   string
+	namespace string
+	installed *InstallConfig
+}
+
+// Installed returns the configuration of the operator found by Check.
+func (u *Upgrade) Installed() *InstallConfig {
+	return u.installed
+}
+
+// Check detects the operator installed in the namespace and validates the upgrade to the new version.
+// It returns a warning for every field of the existing custom resources which the new CRDs do not have,
+// as the operator would no longer act on them.
+func (u *Upgrade) Check(ctx context.Context) ([]string, error) {
+	deployment, err := findOperatorDeployment(ctx, u.kubectl, u.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	installed, err := installedConfig(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.validateVersion(installed.Version); err != nil {
+		return nil, err
+	}
+
+	warnings, err := u.unsupportedFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u.installed = installed
+
+	return warnings, nil
+}
+
+func (u *Upgrade) validateVersion(installedVersion string) error {
+	from, err := semver.NewVersion(installedVersion)
+	if err != nil {
+		return fmt.Errorf("installed operator version %s is invalid", installedVersion)
+	}
+
+	to, err := semver.NewVersion(u.version)
+	if err != nil {
+		return fmt.Errorf("requested operator version %s is invalid", u.version)
+	}
+
+	if !to.GreaterThan(from) {
+		return fmt.Errorf("operator version %s is installed, it can not be upgraded to %s", installedVersion, u.version)
+	}
+
+	isSupported, err := u.versionProvider.IsSupported(u.version)
+	if err != nil {
+		return err
+	}
+
+	if !isSupported {
+		return fmt.Errorf("upgrading from version %s to %s is not supported", installedVersion, u.version)
+	}
+
+	return nil
+}
+
+// unsupportedFields lists the custom resources of the curated CRDs and checks their spec against the new CRDs.
+func (u *Upgrade) unsupportedFields(ctx context.Context) ([]string, error) {
+	crds, err := listAtlasCRDs(ctx, u.kubectl)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	for _, crd := range crds {
+		if crd.Spec.Group != curatedCRDGroup {
+			continue
+		}
+
+		customResources, err := listCustomResources(ctx, u.kubectl, []apiextensionsv1.CustomResourceDefinition{crd})
+		if err != nil {
+			return nil, err
+		}
+
+		resourceName := crd.Spec.Group + "_" + crd.Spec.Names.Plural
+		for _, resource := range customResources {
+			name := fmt.Sprintf("%s %s/%s", resource.GetKind(), resource.GetNamespace(), resource.GetName())
+			if !u.featureValidator.IsResourceSupported(resourceName) {
+				warnings = append(warnings, fmt.Sprintf("%s: kind is not supported by operator version %s", name, u.version))
+				continue
+			}
+
+			unknown := map[string]struct{}{}
+			u.unknownFields(resourceName, "", resource.Object["spec"], unknown)
+			for path := range unknown {
+				warnings = append(warnings, fmt.Sprintf("%s: field spec.%s is not supported by operator version %s", name, path, u.version))
+			}
+		}
+	}
+
+	sort.Strings(warnings)
+
+	return warnings, nil
+}
+
+// unknownFields collects the paths of the spec fields the new CRD schema does not have.
+func (u *Upgrade) unknownFields(resourceName, path string, value any, unknown map[string]struct{}) {
+	switch v := value.(type) {
+	case map[string]any:
+		missing := make([]string, 0, len(v))
+		for key, field := range v {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+
+			if !u.featureValidator.FeatureExist(resourceName, fieldPath) {
+				missing = append(missing, fieldPath)
+				continue
+			}
+
+			u.unknownFields(resourceName, fieldPath, field, unknown)
+		}
+
+		// none of the keys of a nested map being in the schema means the map is free form, such as a zone mapping
+		if path != "" && len(missing) == len(v) {
+			return
+		}
+
+		for _, fieldPath := range missing {
+			unknown[fieldPath] = struct{}{}
+		}
+	case []any:
+		for _, item := range v {
+			u.unknownFields(resourceName, path, item, unknown)
+		}
+	}
+}
+
+// Run updates the CRDs, the RBAC objects and the deployment of the operator to the new version,
+// keeping the watched namespaces, the deletion protection and the Atlas domain it was installed with.
+func (u *Upgrade) Run(ctx context.Context) error {
+	if u.installed == nil {
+		if _, err := u.Check(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := u.installResources.InstallCRDs(ctx, u.version, len(u.installed.Watch) > 0); err != nil {
+		return err
+	}
+
+	upgraded := *u.installed
+	upgraded.Version = u.version
+
+	return u.installResources.InstallConfiguration(ctx, &upgraded)
+}
+
+// installedConfig reads the configuration an operator was installed with from its deployment.
+func installedConfig(deployment *appsv1.Deployment) (*InstallConfig, error) {
+	if len(deployment.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("deployment %s has no containers", deployment.Name)
+	}
+	container := deployment.Spec.Template.Spec.Containers[0]
+
+	separator := strings.LastIndex(container.Image, ":")
+	if separator < 0 || !strings.HasSuffix(container.Image[:separator], operatorImage) {
+		return nil, errors.New("unable to detect the installed operator version. only installations of the operator image " + operatorImage + " can be upgraded")
+	}
+
+	config := &InstallConfig{
+		Version:                              container.Image[separator+1:],
+		Namespace:                            deployment.Namespace,
+		ResourceDeletionProtectionEnabled:    true,
+		SubResourceDeletionProtectionEnabled: true,
+	}
+
+	for _, arg := range container.Args {
+		switch {
+		case arg == deletionProtectionOff:
+			config.ResourceDeletionProtectionEnabled = false
+		case arg == subDeletionProtectionOff:
+			config.SubResourceDeletionProtectionEnabled = false
+		case strings.HasPrefix(arg, atlasDomainArg):
+			config.AtlasDomain = strings.TrimPrefix(arg, atlasDomainArg)
+		}
+	}
+
+	config.Watch = watchNamespaces(deployment.Namespace, container)
+
+	return config, nil
+}
+
+// watchNamespaces reads the namespaces an operator watches, none when it watches the whole cluster.
+func watchNamespaces(namespace string, container corev1.Container) []string {
+	var watched []string
+	for _, env := range container.Env {
+		if env.Name != "WATCH_NAMESPACE" {
+			continue
+		}
+
+		// a namespaced operator watching only its own namespace reads it from the pod
+		if env.ValueFrom != nil {
+			return []string{namespace}
+		}
+
+		for _, ns := range strings.Split(env.Value, ",") {
+			if ns != "" {
+				watched = append(watched, ns)
+			}
+		}
+	}
+
+	return watched
+}
+
+func NewUpgrade(
+	installResources Installer,
+	versionProvider version.AtlasOperatorVersionProvider,
+	featureValidator features.FeatureValidator,
+	kubectl *kubernetes.KubeCtl,
+	operatorVersion string,
+	namespace string,
+) *Upgrade {
+	return &Upgrade{
+		installResources: installResources,
+		versionProvider:  versionProvider,
+		featureValidator: featureValidator,
+		kubectl:          kubectl,
+		version:          operatorVersion,
+		namespace:        namespace,
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/mocks"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const upgradeCRDs = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: atlasprojects.atlas.mongodb.com
+  labels:
+    app.kubernetes.io/version: 2.14.0
+spec:
+  group: atlas.mongodb.com
+  names:
+    kind: AtlasProject
+    listKind: AtlasProjectList
+    plural: atlasprojects
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+`
+
+const upgradeConfig = `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: mongodb-atlas-operator
+  namespace: mongodb-atlas-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: mongodb-atlas-manager-role
+  namespace: mongodb-atlas-system
+rules:
+- apiGroups:
+  - atlas.mongodb.com
+  resources:
+  - atlasprojects
+  verbs:
+  - get
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: mongodb-atlas-operator
+  namespace: mongodb-atlas-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: mongodb/mongodb-atlas-kubernetes-operator:2.14.0
+        args:
+        - --atlas-domain=https://cloud.mongodb.com/
+        - --leader-elect
+        env:
+        - name: WATCH_NAMESPACE
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.namespace
+`
+
+// releaseVersionProvider serves the deployment files of a single release.
+type releaseVersionProvider struct {
+	version string
+}
+
+func (p *releaseVersionProvider) GetLatest() (string, error) {
+	return p.version, nil
+}
+
+func (p *releaseVersionProvider) IsSupported(version string) (bool, error) {
+	return version == p.version, nil
+}
+
+func (p *releaseVersionProvider) DownloadResource(_ context.Context, path string) (io.ReadCloser, error) {
+	switch path {
+	case fmt.Sprintf("releases/v%s/deploy/namespaced/crds.yaml", p.version):
+		return io.NopCloser(strings.NewReader(upgradeCRDs)), nil
+	case fmt.Sprintf("releases/v%s/deploy/namespaced/namespaced-config.yaml", p.version):
+		return io.NopCloser(strings.NewReader(upgradeConfig)), nil
+	}
+
+	return nil, fmt.Errorf("%s not found", path)
+}
+
+func TestUpgrade_Run(t *testing.T) {
+	ctx := context.Background()
+
+	newUpgrade := func(t *testing.T, k8sClient client.Client, operatorVersion string) *Upgrade {
+		t.Helper()
+
+		featureValidator := mocks.NewMockFeatureValidator(gomock.NewController(t))
+		featureValidator.EXPECT().IsResourceSupported(gomock.Any()).Return(true).AnyTimes()
+		featureValidator.EXPECT().FeatureExist("atlas.mongodb.com_atlasprojects", gomock.Any()).
+			DoAndReturn(func(_, path string) bool {
+				return path != "regionUsageRestrictions"
+			}).
+			AnyTimes()
+
+		versionProvider := &releaseVersionProvider{version: "2.14.0"}
+		kubectl := kubernetes.NewKubeCtlFromClient(k8sClient)
+		installer := NewInstaller(versionProvider, kubectl, true, true).WithUpgrade(true)
+
+		return NewUpgrade(installer, versionProvider, featureValidator, kubectl, operatorVersion, "atlas")
+	}
+
+	t.Run("updates the operator in place keeping its configuration", func(t *testing.T) {
+		project := atlasProject("my-project")
+		project.Spec.RegionUsageRestrictions = "GOV_REGIONS_ONLY"
+		k8sClient := newInstalledOperator(t, project)
+		upgrade := newUpgrade(t, k8sClient, "2.14.0")
+
+		// fields set by others than the installation
+		installed := &appsv1.Deployment{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "mongodb-atlas-operator", Namespace: "atlas"}, installed))
+		installed.Spec.Replicas = pointer.Get[int32](3)
+		installed.Annotations = map[string]string{"team": "platform"}
+		installed.Spec.Template.Spec.Containers[0].Env = append(installed.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy:3128"})
+		require.NoError(t, k8sClient.Update(ctx, installed))
+
+		warnings, err := upgrade.Check(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"AtlasProject apps/my-project: field spec.regionUsageRestrictions is not supported by operator version 2.14.0"}, warnings)
+		assert.Equal(t, "2.13.0", upgrade.Installed().Version)
+
+		require.NoError(t, upgrade.Run(ctx))
+
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "mongodb-atlas-operator", Namespace: "atlas"}, deployment))
+		container := deployment.Spec.Template.Spec.Containers[0]
+		assert.Equal(t, "mongodb/mongodb-atlas-kubernetes-operator:2.14.0", container.Image)
+		assert.Equal(t, []string{"--atlas-domain=https://cloud-qa.mongodb.com/", "--leader-elect", "--object-deletion-protection=false"}, container.Args)
+		require.Len(t, container.Env, 2)
+		assert.Equal(t, "WATCH_NAMESPACE", container.Env[0].Name)
+		assert.ElementsMatch(t, []string{"atlas", "apps"}, strings.Split(container.Env[0].Value, ","))
+		assert.Equal(t, corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}, container.Env[1])
+		assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+		assert.Equal(t, "platform", deployment.Annotations["team"])
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "atlasprojects.atlas.mongodb.com"}, crd))
+		assert.Equal(t, "2.14.0", crd.Labels["app.kubernetes.io/version"])
+
+		role := &rbacv1.Role{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "mongodb-atlas-manager-role", Namespace: "apps"}, role))
+		assert.Len(t, role.Rules, 1)
+	})
+
+	t.Run("refuses to downgrade the operator", func(t *testing.T) {
+		upgrade := newUpgrade(t, newInstalledOperator(t), "2.12.0")
+
+		_, err := upgrade.Check(ctx)
+		require.EqualError(t, err, "operator version 2.13.0 is installed, it can not be upgraded to 2.12.0")
+	})
+
+	t.Run("refuses an unsupported version", func(t *testing.T) {
+		upgrade := newUpgrade(t, newInstalledOperator(t), "2.15.0")
+
+		_, err := upgrade.Check(ctx)
+		require.EqualError(t, err, "upgrading from version 2.13.0 to 2.15.0 is not supported")
+	})
+
+	t.Run("fails when the installed version is unknown", func(t *testing.T) {
+		k8sClient := newInstalledOperator(t)
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "mongodb-atlas-operator", Namespace: "atlas"}, deployment))
+		deployment.Spec.Template.Spec.Containers[0].Image = "registry.connect.redhat.com/mongodb/mongodb-atlas-kubernetes-operator@sha256:0123"
+		require.NoError(t, k8sClient.Update(ctx, deployment))
+
+		_, err := newUpgrade(t, k8sClient, "2.14.0").Check(ctx)
+		require.ErrorContains(t, err, "unable to detect the installed operator version")
+	})
+}
+
+func TestInstalledConfig(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "mongodb-atlas-operator", Namespace: "atlas"},
+	}
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{{
+		Image: "my-registry:5000/mongodb/mongodb-atlas-kubernetes-operator:2.13.1",
+		Args:  []string{"--atlas-domain=https://cloud.mongodbgov.com/", "--subobject-deletion-protection=false"},
+	}}
+
+	config, err := installedConfig(deployment)
+	require.NoError(t, err)
+	assert.Equal(t, &InstallConfig{
+		Version:                              "2.13.1",
+		Namespace:                            "atlas",
+		ResourceDeletionProtectionEnabled:    true,
+		SubResourceDeletionProtectionEnabled: false,
+		AtlasDomain:                          "https://cloud.mongodbgov.com/",
+	}, config)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store (interfaces: ProjectAPIKeyCreator,OrganizationAPIKeyCreator,ProjectAPIKeyAssigner)
+// Source: github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store (interfaces: ProjectAPIKeyCreator,OrganizationAPIKeyCreator,ProjectAPIKeyAssigner,OrganizationAPIKeyDeleter)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -137,3 +137,55 @@ func (mr *MockProjectAPIKeyAssignerMockRecorder) AssignProjectAPIKey(arg0, arg1,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectAPIKey", reflect.TypeOf((*MockProjectAPIKeyAssigner)(nil).AssignProjectAPIKey), arg0, arg1, arg2)
 }
+
+// MockOrganizationAPIKeyDeleter is a mock of OrganizationAPIKeyDeleter interface.
+type MockOrganizationAPIKeyDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationAPIKeyDeleterMockRecorder
+}
+
+// MockOrganizationAPIKeyDeleterMockRecorder is the mock recorder for MockOrganizationAPIKeyDeleter.
+type MockOrganizationAPIKeyDeleterMockRecorder struct {
+	mock *MockOrganizationAPIKeyDeleter
+}
+
+// NewMockOrganizationAPIKeyDeleter creates a new mock instance.
+func NewMockOrganizationAPIKeyDeleter(ctrl *gomock.Controller) *MockOrganizationAPIKeyDeleter {
+	mock := &MockOrganizationAPIKeyDeleter{ctrl: ctrl}
+	mock.recorder = &MockOrganizationAPIKeyDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationAPIKeyDeleter) EXPECT() *MockOrganizationAPIKeyDeleterMockRecorder {
+	return m.recorder
+}
+
+// AllOrganizationAPIKeys mocks base method.
+func (m *MockOrganizationAPIKeyDeleter) AllOrganizationAPIKeys(arg0 string) ([]admin.ApiKeyUserDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllOrganizationAPIKeys", arg0)
+	ret0, _ := ret[0].([]admin.ApiKeyUserDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllOrganizationAPIKeys indicates an expected call of AllOrganizationAPIKeys.
+func (mr *MockOrganizationAPIKeyDeleterMockRecorder) AllOrganizationAPIKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllOrganizationAPIKeys", reflect.TypeOf((*MockOrganizationAPIKeyDeleter)(nil).AllOrganizationAPIKeys), arg0)
+}
+
+// DeleteOrganizationAPIKey mocks base method.
+func (m *MockOrganizationAPIKeyDeleter) DeleteOrganizationAPIKey(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationAPIKey indicates an expected call of DeleteOrganizationAPIKey.
+func (mr *MockOrganizationAPIKeyDeleterMockRecorder) DeleteOrganizationAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationAPIKey", reflect.TypeOf((*MockOrganizationAPIKeyDeleter)(nil).DeleteOrganizationAPIKey), arg0, arg1)
+}
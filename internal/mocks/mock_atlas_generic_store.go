@@ -49,6 +49,20 @@ func (mr *MockOperatorGenericStoreMockRecorder) AddIPAccessList(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIPAccessList", reflect.TypeOf((*MockOperatorGenericStore)(nil).AddIPAccessList), arg0, arg1, arg2)
 }
 
+// AddServiceAccountIPAccessList mocks base method.
+func (m *MockOperatorGenericStore) AddServiceAccountIPAccessList(arg0, arg1 string, arg2 *[]admin0.ServiceAccountIPAccessListEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccountIPAccessList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceAccountIPAccessList indicates an expected call of AddServiceAccountIPAccessList.
+func (mr *MockOperatorGenericStoreMockRecorder) AddServiceAccountIPAccessList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccountIPAccessList", reflect.TypeOf((*MockOperatorGenericStore)(nil).AddServiceAccountIPAccessList), arg0, arg1, arg2)
+}
+
 // AlertConfigurations mocks base method.
 func (m *MockOperatorGenericStore) AlertConfigurations(arg0 string) ([]admin0.GroupAlertsConfig, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +78,36 @@ func (mr *MockOperatorGenericStoreMockRecorder) AlertConfigurations(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockOperatorGenericStore)(nil).AlertConfigurations), arg0)
 }
 
+// AllOrgProjects mocks base method.
+func (m *MockOperatorGenericStore) AllOrgProjects(arg0 string) ([]admin0.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllOrgProjects", arg0)
+	ret0, _ := ret[0].([]admin0.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllOrgProjects indicates an expected call of AllOrgProjects.
+func (mr *MockOperatorGenericStoreMockRecorder) AllOrgProjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllOrgProjects", reflect.TypeOf((*MockOperatorGenericStore)(nil).AllOrgProjects), arg0)
+}
+
+// AllOrganizationAPIKeys mocks base method.
+func (m *MockOperatorGenericStore) AllOrganizationAPIKeys(arg0 string) ([]admin0.ApiKeyUserDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllOrganizationAPIKeys", arg0)
+	ret0, _ := ret[0].([]admin0.ApiKeyUserDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllOrganizationAPIKeys indicates an expected call of AllOrganizationAPIKeys.
+func (mr *MockOperatorGenericStoreMockRecorder) AllOrganizationAPIKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllOrganizationAPIKeys", reflect.TypeOf((*MockOperatorGenericStore)(nil).AllOrganizationAPIKeys), arg0)
+}
+
 // AssignProjectAPIKey mocks base method.
 func (m *MockOperatorGenericStore) AssignProjectAPIKey(arg0, arg1 string, arg2 *admin0.UpdateAtlasProjectApiKey) error {
 	m.ctrl.T.Helper()
@@ -78,6 +122,20 @@ func (mr *MockOperatorGenericStoreMockRecorder) AssignProjectAPIKey(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectAPIKey", reflect.TypeOf((*MockOperatorGenericStore)(nil).AssignProjectAPIKey), arg0, arg1, arg2)
 }
 
+// AssignProjectServiceAccount mocks base method.
+func (m *MockOperatorGenericStore) AssignProjectServiceAccount(arg0, arg1 string, arg2 *admin0.GroupServiceAccountRoleAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProjectServiceAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignProjectServiceAccount indicates an expected call of AssignProjectServiceAccount.
+func (mr *MockOperatorGenericStoreMockRecorder) AssignProjectServiceAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectServiceAccount", reflect.TypeOf((*MockOperatorGenericStore)(nil).AssignProjectServiceAccount), arg0, arg1, arg2)
+}
+
 // AtlasCluster mocks base method.
 func (m *MockOperatorGenericStore) AtlasCluster(arg0, arg1 string) (*admin.AdvancedClusterDescription, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +211,21 @@ func (mr *MockOperatorGenericStoreMockRecorder) CreateOrganizationAPIKey(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationAPIKey", reflect.TypeOf((*MockOperatorGenericStore)(nil).CreateOrganizationAPIKey), arg0, arg1)
 }
 
+// CreateOrganizationServiceAccount mocks base method.
+func (m *MockOperatorGenericStore) CreateOrganizationServiceAccount(arg0 string, arg1 *admin0.OrgServiceAccountRequest) (*admin0.OrgServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*admin0.OrgServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationServiceAccount indicates an expected call of CreateOrganizationServiceAccount.
+func (mr *MockOperatorGenericStoreMockRecorder) CreateOrganizationServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationServiceAccount", reflect.TypeOf((*MockOperatorGenericStore)(nil).CreateOrganizationServiceAccount), arg0, arg1)
+}
+
 // CreateProject mocks base method.
 func (m *MockOperatorGenericStore) CreateProject(arg0 *admin0.CreateProjectApiParams) (*admin0.Group, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +256,21 @@ func (mr *MockOperatorGenericStoreMockRecorder) CreateProjectAPIKey(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAPIKey", reflect.TypeOf((*MockOperatorGenericStore)(nil).CreateProjectAPIKey), arg0, arg1)
 }
 
+// CreateProjectServiceAccount mocks base method.
+func (m *MockOperatorGenericStore) CreateProjectServiceAccount(arg0 string, arg1 *admin0.GroupServiceAccountRequest) (*admin0.GroupServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*admin0.GroupServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectServiceAccount indicates an expected call of CreateProjectServiceAccount.
+func (mr *MockOperatorGenericStoreMockRecorder) CreateProjectServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectServiceAccount", reflect.TypeOf((*MockOperatorGenericStore)(nil).CreateProjectServiceAccount), arg0, arg1)
+}
+
 // DataFederation mocks base method.
 func (m *MockOperatorGenericStore) DataFederation(arg0, arg1 string) (*admin0.DataLakeTenant, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +331,34 @@ func (mr *MockOperatorGenericStoreMockRecorder) DatabaseUsers(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockOperatorGenericStore)(nil).DatabaseUsers), arg0)
 }
 
+// DeleteOrganizationAPIKey mocks base method.
+func (m *MockOperatorGenericStore) DeleteOrganizationAPIKey(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationAPIKey indicates an expected call of DeleteOrganizationAPIKey.
+func (mr *MockOperatorGenericStoreMockRecorder) DeleteOrganizationAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationAPIKey", reflect.TypeOf((*MockOperatorGenericStore)(nil).DeleteOrganizationAPIKey), arg0, arg1)
+}
+
+// DeleteOrganizationServiceAccount mocks base method.
+func (m *MockOperatorGenericStore) DeleteOrganizationServiceAccount(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationServiceAccount indicates an expected call of DeleteOrganizationServiceAccount.
+func (mr *MockOperatorGenericStoreMockRecorder) DeleteOrganizationServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationServiceAccount", reflect.TypeOf((*MockOperatorGenericStore)(nil).DeleteOrganizationServiceAccount), arg0, arg1)
+}
+
 // DescribeCompliancePolicy mocks base method.
 func (m *MockOperatorGenericStore) DescribeCompliancePolicy(arg0 string) (*admin0.DataProtectionSettings20231001, error) {
 	m.ctrl.T.Helper()
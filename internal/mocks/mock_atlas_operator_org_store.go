@@ -48,6 +48,35 @@ func (mr *MockOperatorOrgStoreMockRecorder) AddIPAccessList(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIPAccessList", reflect.TypeOf((*MockOperatorOrgStore)(nil).AddIPAccessList), arg0, arg1, arg2)
 }
 
+// AddServiceAccountIPAccessList mocks base method.
+func (m *MockOperatorOrgStore) AddServiceAccountIPAccessList(arg0, arg1 string, arg2 *[]admin.ServiceAccountIPAccessListEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccountIPAccessList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceAccountIPAccessList indicates an expected call of AddServiceAccountIPAccessList.
+func (mr *MockOperatorOrgStoreMockRecorder) AddServiceAccountIPAccessList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccountIPAccessList", reflect.TypeOf((*MockOperatorOrgStore)(nil).AddServiceAccountIPAccessList), arg0, arg1, arg2)
+}
+
+// AllOrganizationAPIKeys mocks base method.
+func (m *MockOperatorOrgStore) AllOrganizationAPIKeys(arg0 string) ([]admin.ApiKeyUserDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllOrganizationAPIKeys", arg0)
+	ret0, _ := ret[0].([]admin.ApiKeyUserDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllOrganizationAPIKeys indicates an expected call of AllOrganizationAPIKeys.
+func (mr *MockOperatorOrgStoreMockRecorder) AllOrganizationAPIKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllOrganizationAPIKeys", reflect.TypeOf((*MockOperatorOrgStore)(nil).AllOrganizationAPIKeys), arg0)
+}
+
 // AssignProjectAPIKey mocks base method.
 func (m *MockOperatorOrgStore) AssignProjectAPIKey(arg0, arg1 string, arg2 *admin.UpdateAtlasProjectApiKey) error {
 	m.ctrl.T.Helper()
@@ -62,6 +91,20 @@ func (mr *MockOperatorOrgStoreMockRecorder) AssignProjectAPIKey(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectAPIKey", reflect.TypeOf((*MockOperatorOrgStore)(nil).AssignProjectAPIKey), arg0, arg1, arg2)
 }
 
+// AssignProjectServiceAccount mocks base method.
+func (m *MockOperatorOrgStore) AssignProjectServiceAccount(arg0, arg1 string, arg2 *admin.GroupServiceAccountRoleAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProjectServiceAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignProjectServiceAccount indicates an expected call of AssignProjectServiceAccount.
+func (mr *MockOperatorOrgStoreMockRecorder) AssignProjectServiceAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectServiceAccount", reflect.TypeOf((*MockOperatorOrgStore)(nil).AssignProjectServiceAccount), arg0, arg1, arg2)
+}
+
 // CreateOrganizationAPIKey mocks base method.
 func (m *MockOperatorOrgStore) CreateOrganizationAPIKey(arg0 string, arg1 *admin.CreateAtlasOrganizationApiKey) (*admin.ApiKeyUserDetails, error) {
 	m.ctrl.T.Helper()
@@ -76,3 +119,46 @@ func (mr *MockOperatorOrgStoreMockRecorder) CreateOrganizationAPIKey(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationAPIKey", reflect.TypeOf((*MockOperatorOrgStore)(nil).CreateOrganizationAPIKey), arg0, arg1)
 }
+
+// CreateOrganizationServiceAccount mocks base method.
+func (m *MockOperatorOrgStore) CreateOrganizationServiceAccount(arg0 string, arg1 *admin.OrgServiceAccountRequest) (*admin.OrgServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*admin.OrgServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationServiceAccount indicates an expected call of CreateOrganizationServiceAccount.
+func (mr *MockOperatorOrgStoreMockRecorder) CreateOrganizationServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationServiceAccount", reflect.TypeOf((*MockOperatorOrgStore)(nil).CreateOrganizationServiceAccount), arg0, arg1)
+}
+
+// DeleteOrganizationAPIKey mocks base method.
+func (m *MockOperatorOrgStore) DeleteOrganizationAPIKey(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationAPIKey indicates an expected call of DeleteOrganizationAPIKey.
+func (mr *MockOperatorOrgStoreMockRecorder) DeleteOrganizationAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationAPIKey", reflect.TypeOf((*MockOperatorOrgStore)(nil).DeleteOrganizationAPIKey), arg0, arg1)
+}
+
+// DeleteOrganizationServiceAccount mocks base method.
+func (m *MockOperatorOrgStore) DeleteOrganizationServiceAccount(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationServiceAccount indicates an expected call of DeleteOrganizationServiceAccount.
+func (mr *MockOperatorOrgStoreMockRecorder) DeleteOrganizationServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationServiceAccount", reflect.TypeOf((*MockOperatorOrgStore)(nil).DeleteOrganizationServiceAccount), arg0, arg1)
+}
@@ -49,6 +49,21 @@ func (mr *MockOperatorProjectStoreMockRecorder) AlertConfigurations(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockOperatorProjectStore)(nil).AlertConfigurations), arg0)
 }
 
+// AllOrgProjects mocks base method.
+func (m *MockOperatorProjectStore) AllOrgProjects(arg0 string) ([]admin.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllOrgProjects", arg0)
+	ret0, _ := ret[0].([]admin.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllOrgProjects indicates an expected call of AllOrgProjects.
+func (mr *MockOperatorProjectStoreMockRecorder) AllOrgProjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllOrgProjects", reflect.TypeOf((*MockOperatorProjectStore)(nil).AllOrgProjects), arg0)
+}
+
 // Auditing mocks base method.
 func (m *MockOperatorProjectStore) Auditing(arg0 string) (*admin.AuditLog, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +124,21 @@ func (mr *MockOperatorProjectStoreMockRecorder) CreateProjectAPIKey(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAPIKey", reflect.TypeOf((*MockOperatorProjectStore)(nil).CreateProjectAPIKey), arg0, arg1)
 }
 
+// CreateProjectServiceAccount mocks base method.
+func (m *MockOperatorProjectStore) CreateProjectServiceAccount(arg0 string, arg1 *admin.GroupServiceAccountRequest) (*admin.GroupServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*admin.GroupServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectServiceAccount indicates an expected call of CreateProjectServiceAccount.
+func (mr *MockOperatorProjectStoreMockRecorder) CreateProjectServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectServiceAccount", reflect.TypeOf((*MockOperatorProjectStore)(nil).CreateProjectServiceAccount), arg0, arg1)
+}
+
 // DatabaseRoles mocks base method.
 func (m *MockOperatorProjectStore) DatabaseRoles(arg0 string) ([]admin.UserCustomDBRole, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +201,21 @@ func (m *MockOrgProjectLister) EXPECT() *MockOrgProjectListerMockRecorder {
 	return m.recorder
 }
 
+// AllOrgProjects mocks base method.
+func (m *MockOrgProjectLister) AllOrgProjects(arg0 string) ([]admin.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllOrgProjects", arg0)
+	ret0, _ := ret[0].([]admin.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllOrgProjects indicates an expected call of AllOrgProjects.
+func (mr *MockOrgProjectListerMockRecorder) AllOrgProjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllOrgProjects", reflect.TypeOf((*MockOrgProjectLister)(nil).AllOrgProjects), arg0)
+}
+
 // GetOrgProjects mocks base method.
 func (m *MockOrgProjectLister) GetOrgProjects(arg0 string) (*admin.PaginatedAtlasGroup, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store (interfaces: ProjectServiceAccountCreator,OrganizationServiceAccountCreator,ProjectServiceAccountAssigner,OrganizationServiceAccountDeleter)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	admin "go.mongodb.org/atlas-sdk/v20250312006/admin"
+)
+
+// MockProjectServiceAccountCreator is a mock of ProjectServiceAccountCreator interface.
+type MockProjectServiceAccountCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectServiceAccountCreatorMockRecorder
+}
+
+// MockProjectServiceAccountCreatorMockRecorder is the mock recorder for MockProjectServiceAccountCreator.
+type MockProjectServiceAccountCreatorMockRecorder struct {
+	mock *MockProjectServiceAccountCreator
+}
+
+// NewMockProjectServiceAccountCreator creates a new mock instance.
+func NewMockProjectServiceAccountCreator(ctrl *gomock.Controller) *MockProjectServiceAccountCreator {
+	mock := &MockProjectServiceAccountCreator{ctrl: ctrl}
+	mock.recorder = &MockProjectServiceAccountCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectServiceAccountCreator) EXPECT() *MockProjectServiceAccountCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateProjectServiceAccount mocks base method.
+func (m *MockProjectServiceAccountCreator) CreateProjectServiceAccount(arg0 string, arg1 *admin.GroupServiceAccountRequest) (*admin.GroupServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*admin.GroupServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectServiceAccount indicates an expected call of CreateProjectServiceAccount.
+func (mr *MockProjectServiceAccountCreatorMockRecorder) CreateProjectServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectServiceAccount", reflect.TypeOf((*MockProjectServiceAccountCreator)(nil).CreateProjectServiceAccount), arg0, arg1)
+}
+
+// MockOrganizationServiceAccountCreator is a mock of OrganizationServiceAccountCreator interface.
+type MockOrganizationServiceAccountCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationServiceAccountCreatorMockRecorder
+}
+
+// MockOrganizationServiceAccountCreatorMockRecorder is the mock recorder for MockOrganizationServiceAccountCreator.
+type MockOrganizationServiceAccountCreatorMockRecorder struct {
+	mock *MockOrganizationServiceAccountCreator
+}
+
+// NewMockOrganizationServiceAccountCreator creates a new mock instance.
+func NewMockOrganizationServiceAccountCreator(ctrl *gomock.Controller) *MockOrganizationServiceAccountCreator {
+	mock := &MockOrganizationServiceAccountCreator{ctrl: ctrl}
+	mock.recorder = &MockOrganizationServiceAccountCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationServiceAccountCreator) EXPECT() *MockOrganizationServiceAccountCreatorMockRecorder {
+	return m.recorder
+}
+
+// AddServiceAccountIPAccessList mocks base method.
+func (m *MockOrganizationServiceAccountCreator) AddServiceAccountIPAccessList(arg0, arg1 string, arg2 *[]admin.ServiceAccountIPAccessListEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccountIPAccessList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceAccountIPAccessList indicates an expected call of AddServiceAccountIPAccessList.
+func (mr *MockOrganizationServiceAccountCreatorMockRecorder) AddServiceAccountIPAccessList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccountIPAccessList", reflect.TypeOf((*MockOrganizationServiceAccountCreator)(nil).AddServiceAccountIPAccessList), arg0, arg1, arg2)
+}
+
+// CreateOrganizationServiceAccount mocks base method.
+func (m *MockOrganizationServiceAccountCreator) CreateOrganizationServiceAccount(arg0 string, arg1 *admin.OrgServiceAccountRequest) (*admin.OrgServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*admin.OrgServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationServiceAccount indicates an expected call of CreateOrganizationServiceAccount.
+func (mr *MockOrganizationServiceAccountCreatorMockRecorder) CreateOrganizationServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationServiceAccount", reflect.TypeOf((*MockOrganizationServiceAccountCreator)(nil).CreateOrganizationServiceAccount), arg0, arg1)
+}
+
+// MockProjectServiceAccountAssigner is a mock of ProjectServiceAccountAssigner interface.
+type MockProjectServiceAccountAssigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectServiceAccountAssignerMockRecorder
+}
+
+// MockProjectServiceAccountAssignerMockRecorder is the mock recorder for MockProjectServiceAccountAssigner.
+type MockProjectServiceAccountAssignerMockRecorder struct {
+	mock *MockProjectServiceAccountAssigner
+}
+
+// NewMockProjectServiceAccountAssigner creates a new mock instance.
+func NewMockProjectServiceAccountAssigner(ctrl *gomock.Controller) *MockProjectServiceAccountAssigner {
+	mock := &MockProjectServiceAccountAssigner{ctrl: ctrl}
+	mock.recorder = &MockProjectServiceAccountAssignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectServiceAccountAssigner) EXPECT() *MockProjectServiceAccountAssignerMockRecorder {
+	return m.recorder
+}
+
+// AssignProjectServiceAccount mocks base method.
+func (m *MockProjectServiceAccountAssigner) AssignProjectServiceAccount(arg0, arg1 string, arg2 *admin.GroupServiceAccountRoleAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProjectServiceAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignProjectServiceAccount indicates an expected call of AssignProjectServiceAccount.
+func (mr *MockProjectServiceAccountAssignerMockRecorder) AssignProjectServiceAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectServiceAccount", reflect.TypeOf((*MockProjectServiceAccountAssigner)(nil).AssignProjectServiceAccount), arg0, arg1, arg2)
+}
+
+// MockOrganizationServiceAccountDeleter is a mock of OrganizationServiceAccountDeleter interface.
+type MockOrganizationServiceAccountDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationServiceAccountDeleterMockRecorder
+}
+
+// MockOrganizationServiceAccountDeleterMockRecorder is the mock recorder for MockOrganizationServiceAccountDeleter.
+type MockOrganizationServiceAccountDeleterMockRecorder struct {
+	mock *MockOrganizationServiceAccountDeleter
+}
+
+// NewMockOrganizationServiceAccountDeleter creates a new mock instance.
+func NewMockOrganizationServiceAccountDeleter(ctrl *gomock.Controller) *MockOrganizationServiceAccountDeleter {
+	mock := &MockOrganizationServiceAccountDeleter{ctrl: ctrl}
+	mock.recorder = &MockOrganizationServiceAccountDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationServiceAccountDeleter) EXPECT() *MockOrganizationServiceAccountDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteOrganizationServiceAccount mocks base method.
+func (m *MockOrganizationServiceAccountDeleter) DeleteOrganizationServiceAccount(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationServiceAccount indicates an expected call of DeleteOrganizationServiceAccount.
+func (mr *MockOrganizationServiceAccountDeleterMockRecorder) DeleteOrganizationServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationServiceAccount", reflect.TypeOf((*MockOrganizationServiceAccountDeleter)(nil).DeleteOrganizationServiceAccount), arg0, arg1)
+}
@@ -15,10 +15,12 @@
 package store
 
 import (
+	"fmt"
+
 	atlasv2 "go.mongodb.org/atlas-sdk/v20250312006/admin"
 )
 
-//go:generate mockgen -destination=../mocks/mock_api_keys.go -package=mocks github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store ProjectAPIKeyCreator,OrganizationAPIKeyCreator,ProjectAPIKeyAssigner
+//go:generate mockgen -destination=../mocks/mock_api_keys.go -package=mocks github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store ProjectAPIKeyCreator,OrganizationAPIKeyCreator,ProjectAPIKeyAssigner,OrganizationAPIKeyDeleter
 
 type ProjectAPIKeyCreator interface {
 	CreateProjectAPIKey(string, *atlasv2.CreateAtlasProjectApiKey) (*atlasv2.ApiKeyUserDetails, error)
@@ -32,6 +34,11 @@ type OrganizationAPIKeyCreator interface {
 	AddIPAccessList(string, string, *[]atlasv2.UserAccessListRequest) error
 }
 
+type OrganizationAPIKeyDeleter interface {
+	AllOrganizationAPIKeys(string) ([]atlasv2.ApiKeyUserDetails, error)
+	DeleteOrganizationAPIKey(string, string) error
+}
+
 // CreateOrganizationAPIKey encapsulates the logic to manage different cloud providers.
 func (s *Store) CreateOrganizationAPIKey(orgID string, input *atlasv2.CreateAtlasOrganizationApiKey) (*atlasv2.ApiKeyUserDetails, error) {
 	result, _, err := s.clientv2.ProgrammaticAPIKeysApi.CreateApiKey(s.ctx, orgID, input).Execute()
@@ -55,3 +62,24 @@ func (s *Store) AssignProjectAPIKey(projectID, apiKeyID string, input *atlasv2.U
 	_, _, err := s.clientv2.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(s.ctx, projectID, apiKeyID, input).Execute()
 	return err
 }
+
+// AllOrganizationAPIKeys returns every API key of the organization.
+func (s *Store) AllOrganizationAPIKeys(orgID string) ([]atlasv2.ApiKeyUserDetails, error) {
+	return AllPages(func(pageNum, itemsPerPage int) ([]atlasv2.ApiKeyUserDetails, error) {
+		page, _, err := s.clientv2.ProgrammaticAPIKeysApi.ListApiKeys(s.ctx, orgID).
+			PageNum(pageNum).
+			ItemsPerPage(itemsPerPage).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization API keys: %w", err)
+		}
+
+		return page.GetResults(), nil
+	})
+}
+
+// DeleteOrganizationAPIKey deletes an API key of the organization.
+func (s *Store) DeleteOrganizationAPIKey(orgID, apiKeyID string) error {
+	_, err := s.clientv2.ProgrammaticAPIKeysApi.DeleteApiKey(s.ctx, orgID, apiKeyID).Execute()
+	return err
+}
@@ -41,6 +41,7 @@ type OperatorProjectStore interface {
 	AlertConfigurationLister
 	DatabaseRoleLister
 	ProjectAPIKeyCreator
+	ProjectServiceAccountCreator
 	CompliancePolicyDescriber
 }
 
@@ -77,6 +78,10 @@ type OperatorTeamsStore interface {
 type OperatorOrgStore interface {
 	OrganizationAPIKeyCreator
 	ProjectAPIKeyAssigner
+	OrganizationServiceAccountCreator
+	ProjectServiceAccountAssigner
+	OrganizationAPIKeyDeleter
+	OrganizationServiceAccountDeleter
 }
 
 type StreamProcessingStore interface {
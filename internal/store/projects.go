@@ -15,6 +15,8 @@
 package store
 
 import (
+	"fmt"
+
 	atlasv2 "go.mongodb.org/atlas-sdk/v20250312006/admin"
 )
 
@@ -27,6 +29,7 @@ type ProjectLister interface {
 type OrgProjectLister interface {
 	ProjectLister
 	GetOrgProjects(string) (*atlasv2.PaginatedAtlasGroup, error)
+	AllOrgProjects(string) ([]atlasv2.Group, error)
 }
 
 type ProjectCreator interface {
@@ -56,6 +59,21 @@ func (s *Store) GetOrgProjects(orgID string) (*atlasv2.PaginatedAtlasGroup, erro
 	return result, err
 }
 
+// AllOrgProjects returns every project of the organization.
+func (s *Store) AllOrgProjects(orgID string) ([]atlasv2.Group, error) {
+	return AllPages(func(pageNum, itemsPerPage int) ([]atlasv2.Group, error) {
+		page, _, err := s.clientv2.OrganizationsApi.ListOrganizationProjects(s.ctx, orgID).
+			PageNum(pageNum).
+			ItemsPerPage(itemsPerPage).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization projects: %w", err)
+		}
+
+		return page.GetResults(), nil
+	})
+}
+
 // Project encapsulates the logic to manage different cloud providers.
 func (s *Store) Project(id string) (*atlasv2.Group, error) {
 	result, _, err := s.clientv2.ProjectsApi.GetProject(s.ctx, id).Execute()
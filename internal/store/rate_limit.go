@@ -0,0 +1,95 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/log"
+)
+
+const (
+	rateLimitMaxRetries = 5
+	rateLimitBaseDelay  = time.Second
+	rateLimitMaxDelay   = 30 * time.Second
+)
+
+// rateLimitTransport retries requests rejected by Atlas with HTTP 429 Too Many Requests.
+// It waits as long as the Retry-After header asks or, without it, backs off exponentially.
+type rateLimitTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+func newRateLimitTransport(base http.RoundTripper) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{
+		base:       base,
+		maxRetries: rateLimitMaxRetries,
+		baseDelay:  rateLimitBaseDelay,
+		maxDelay:   rateLimitMaxDelay,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		// a request with a body can only be sent again if the body can be recreated
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		delay := t.delay(resp, attempt)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		_, _ = log.Debugf("rate limited by Atlas on %s %s, retrying in %s\n", req.Method, req.URL.Path, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *rateLimitTransport) delay(resp *http.Response, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, t.maxDelay)
+	}
+
+	return min(t.baseDelay<<attempt, t.maxDelay)
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unit
+
+package store
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	newServer := func(rateLimited int32) (*httptest.Server, *atomic.Int32) {
+		calls := &atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if calls.Add(1) <= rateLimited {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write(body)
+		}))
+		return server, calls
+	}
+
+	newClient := func() *http.Client {
+		transport := newRateLimitTransport(nil)
+		transport.baseDelay = time.Millisecond
+		return &http.Client{Transport: transport}
+	}
+
+	t.Run("should retry rate limited requests", func(t *testing.T) {
+		server, calls := newServer(2)
+		defer server.Close()
+
+		resp, err := newClient().Post(server.URL, "text/plain", strings.NewReader("payload"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "payload", string(body))
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("should give up after the maximum number of retries", func(t *testing.T) {
+		server, calls := newServer(100)
+		defer server.Close()
+
+		resp, err := newClient().Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, int32(rateLimitMaxRetries+1), calls.Load())
+	})
+
+	t.Run("should honour Retry-After", func(t *testing.T) {
+		transport := newRateLimitTransport(nil)
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+		assert.Equal(t, 2*time.Second, transport.delay(resp, 0))
+		assert.Equal(t, 4*time.Second, transport.delay(&http.Response{Header: http.Header{}}, 2))
+		assert.Equal(t, rateLimitMaxDelay, transport.delay(&http.Response{Header: http.Header{}}, 10))
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312006/admin"
+)
+
+//go:generate mockgen -destination=../mocks/mock_service_accounts.go -package=mocks github.com/mongodb/atlas-cli-plugin-kubernetes/internal/store ProjectServiceAccountCreator,OrganizationServiceAccountCreator,ProjectServiceAccountAssigner,OrganizationServiceAccountDeleter
+
+type ProjectServiceAccountCreator interface {
+	CreateProjectServiceAccount(string, *atlasv2.GroupServiceAccountRequest) (*atlasv2.GroupServiceAccount, error)
+}
+
+type ProjectServiceAccountAssigner interface {
+	AssignProjectServiceAccount(string, string, *atlasv2.GroupServiceAccountRoleAssignment) error
+}
+
+type OrganizationServiceAccountCreator interface {
+	CreateOrganizationServiceAccount(string, *atlasv2.OrgServiceAccountRequest) (*atlasv2.OrgServiceAccount, error)
+	AddServiceAccountIPAccessList(string, string, *[]atlasv2.ServiceAccountIPAccessListEntry) error
+}
+
+type OrganizationServiceAccountDeleter interface {
+	DeleteOrganizationServiceAccount(string, string) error
+}
+
+// CreateOrganizationServiceAccount creates a service account for an organization.
+func (s *Store) CreateOrganizationServiceAccount(orgID string, input *atlasv2.OrgServiceAccountRequest) (*atlasv2.OrgServiceAccount, error) {
+	result, _, err := s.clientv2.ServiceAccountsApi.CreateServiceAccount(s.ctx, orgID, input).Execute()
+	return result, err
+}
+
+// AddServiceAccountIPAccessList adds entries to the access list of a service account.
+func (s *Store) AddServiceAccountIPAccessList(orgID, clientID string, ipAccessList *[]atlasv2.ServiceAccountIPAccessListEntry) error {
+	_, _, err := s.clientv2.ServiceAccountsApi.CreateServiceAccountAccessList(s.ctx, orgID, clientID, ipAccessList).Execute()
+	return err
+}
+
+// CreateProjectServiceAccount creates a service account for a project.
+func (s *Store) CreateProjectServiceAccount(projectID string, input *atlasv2.GroupServiceAccountRequest) (*atlasv2.GroupServiceAccount, error) {
+	result, _, err := s.clientv2.ServiceAccountsApi.CreateProjectServiceAccount(s.ctx, projectID, input).Execute()
+	return result, err
+}
+
+// AssignProjectServiceAccount assigns an organization service account to a project with the given roles.
+func (s *Store) AssignProjectServiceAccount(projectID, clientID string, input *atlasv2.GroupServiceAccountRoleAssignment) error {
+	_, _, err := s.clientv2.ServiceAccountsApi.AddProjectServiceAccount(s.ctx, clientID, projectID, input).Execute()
+	return err
+}
+
+// DeleteOrganizationServiceAccount deletes a service account of the organization, which also removes it from its projects.
+func (s *Store) DeleteOrganizationServiceAccount(orgID, clientID string) error {
+	_, err := s.clientv2.ServiceAccountsApi.DeleteServiceAccount(s.ctx, clientID, orgID).Execute()
+	return err
+}
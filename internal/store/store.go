@@ -118,6 +118,7 @@ func WithAuthentication() Option {
 		if err != nil {
 			return err
 		}
+		client.Transport = newRateLimitTransport(client.Transport)
 		s.httpClient = client
 		return nil
 	}
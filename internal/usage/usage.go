@@ -40,4 +40,31 @@ const (
 	WatchTimeout                          = "Time in seconds until a watch times out. After a watch times out, the CLI no longer watches the command."
 	IPAccessList                          = "A comma-separated list of IP or CIDR block to allowlist for Operator to communicate with Atlas APIs. Read more: https://www.mongodb.com/docs/atlas/configure-api-access-project/"
 	CRDType                               = "Type of the CRD to generate. Valid values are 'curated' or 'generated'."
+	OutputDir                             = "Directory where to write one file per generated resource, organised by namespace and kind, along with a kustomization.yaml. Only files whose content changed are rewritten."
+	AllProjects                           = "Flag that exports every project of the organization, each one to its own namespace named after the project. A failure exporting one project does not stop the export of the others."
+	ProjectNameFilter                     = "Glob pattern, e.g. 'prod-*', that project names must match to be exported with --allProjects."
+	ProjectTag                            = "Tag, in the form key=value, that projects must have to be exported with --allProjects. Can be repeated to require several tags."
+	Workers                               = "Maximum number of deployments, data federations and stream instances fetched from Atlas concurrently. Requests rejected by Atlas rate limits are retried with backoff."
+	Include                               = "Custom resource kinds to export, such as AtlasDeployment or AtlasDatabaseUser. All supported kinds are exported when omitted."
+	Exclude                               = "Custom resource kinds not to export, such as AtlasOrgSettings or AtlasTeam."
+	CRDsPath                              = "Directory or tarball to read the Atlas Kubernetes Operator CRDs from instead of the CRDs bundled with the plugin, holding one <crd-name>.yaml file per CRD either at its root or in a v<operator-version> directory. Use it to run without network access for operator versions released after the plugin."
+	OperatorServiceAccount                = "Flag that indicates whether to create an Atlas service account for the operator, which authenticates with OAuth client credentials, instead of a programmatic API key."
+	PushFile                              = "File or directory of YAML manifests of generated CRDs, as written by config generate, to push to Atlas."
+	Force                                 = "Flag that indicates whether to skip the confirmation prompt before proceeding with the requested action."
+	OperatorOutputManifests               = "Flag that indicates whether to print the YAML manifests of the operator and its credentials secret instead of installing them into the cluster. The credentials are still created in Atlas."
+	OperatorVersionUpgrade                = "Version of the operator to upgrade to. It defaults to the latest version."
+	OperatorTargetNamespaceUpgrade        = "Namespace where the operator to upgrade is installed. It is detected from the cluster when omitted."
+	OperatorTargetNamespaceStatus         = "Namespace where the operator is installed. It is detected from the cluster when omitted."
+	OperatorTargetNamespaceUninstall      = "Namespace where the operator to uninstall is installed. It is detected from the cluster when omitted."
+	OperatorDeleteCRDs                    = "Flag that indicates whether to delete the Atlas Kubernetes Operator CRDs, which also deletes their custom resources."
+	OperatorDeleteResources               = "Flag that indicates whether to delete the Atlas custom resources of the namespaces watched by the operator. The Atlas resources they manage are not deleted."
+	OperatorRevokeCredentials             = "Flag that indicates whether to delete from Atlas the API key or service account the operator used."
+	OperatorUninstallForce                = "Flag that indicates whether to delete the CRDs even though custom resources still exist or other operators are installed in the cluster."
+	SecretsBackend                        = "Secret store to reference instead of generating plain Secrets. Valid values are 'external-secrets', generating ExternalSecrets read from --externalSecretStore, or 'sealed-secrets', generating SealedSecrets encrypted with --sealedSecretsCert."
+	ExternalSecretStore                   = "Name of the External Secrets Operator ClusterSecretStore the generated ExternalSecrets read from."
+	ExternalSecretsPath                   = "Template of the path, in the secret store, of each generated ExternalSecret, rendered with the Namespace and Name of the Secret. Each key of the Secret is read from the property of the same name."
+	SealedSecretsCert                     = "Path to the PEM certificate or public key of the Sealed Secrets controller, as fetched with 'kubeseal --fetch-cert', used to encrypt the generated SealedSecrets."
+	GenerateOutput                        = "Output format of the generated resources. Valid values are 'yaml', 'json', rendering a Kubernetes List, or 'helm', writing a Helm chart to --outputDir with the namespace, the secrets and the instance size of each cluster as values."
+	DryRunOutput                          = "Output format of the dry-run report, printed once the job completed. Set to 'json' to print the planned create, update and delete actions as JSON instead of a table. Requires --watch."
+	DryRunImageRegistry                   = "Container registry to pull the operator image of the dry-run job from, such as a mirror of quay.io for clusters without internet access."
 )
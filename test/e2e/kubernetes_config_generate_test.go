@@ -1163,8 +1163,10 @@ func defaultTestUser(name, projectName, namespace string) *akov2.AtlasDatabaseUs
 					DatabaseName: "admin",
 				},
 			},
-			Username: name,
-			X509Type: "MANAGED",
+			Username:     name,
+			OIDCAuthType: "NONE",
+			AWSIAMType:   "NONE",
+			X509Type:     "MANAGED",
 		},
 		Status: akov2status.AtlasDatabaseUserStatus{
 			Common: akoapi.Common{
@@ -0,0 +1,107 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter"
+	"github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/features"
+)
+
+const (
+	bundleDir      = "./internal/kubernetes/operator/crds/bundle"
+	urlTemplate    = "https://raw.githubusercontent.com/mongodb/mongodb-atlas-kubernetes/main/releases/v%s/bundle/manifests/%s.yaml"
+	requestTimeout = 30 * time.Second
+)
+
+// download returns the CRD of a released version, or nil when the release does not have it.
+func download(client *http.Client, resourceName, version string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(urlTemplate, version, resourceName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("can not download %s %s: %s", resourceName, version, resp.Status)
+	}
+}
+
+// save writes a CRD to the directory of its version. Curated CRDs are released by every
+// supported version, generated ones only by the latest versions.
+func save(client *http.Client, versionDir, resourceName, version string, required bool) {
+	data, err := download(client, resourceName, version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if data == nil {
+		if required {
+			log.Fatalf("%s is not released for version %s", resourceName, version)
+		}
+		log.Printf("skipping %s: not released for version %s\n", resourceName, version)
+		return
+	}
+
+	const filePermissions = 0644
+	if err := os.WriteFile(filepath.Join(versionDir, resourceName+".yaml"), data, filePermissions); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// main refreshes the CRDs bundled with the plugin for every supported operator version.
+func main() {
+	if err := os.RemoveAll(bundleDir); err != nil {
+		log.Fatal(err)
+	}
+
+	client := &http.Client{}
+	for _, version := range features.SupportedVersions() {
+		versionDir := filepath.Join(bundleDir, "v"+version)
+		const dirPermissions = 0755
+		if err := os.MkdirAll(versionDir, dirPermissions); err != nil {
+			log.Fatal(err)
+		}
+
+		resources, _ := features.GetResourcesForVersion(version)
+		for _, resourceName := range resources {
+			save(client, versionDir, resourceName, version, true)
+		}
+
+		for _, resource := range exporter.SupportedResources {
+			save(client, versionDir, resource.CRDName, version, false)
+		}
+	}
+}
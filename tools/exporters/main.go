@@ -0,0 +1,378 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// exporters writes an exporter for every kind of the atlas.generated.mongodb.com CRDs, along with
+// the registry of supported resources. The CRDs give the kind, the Atlas SDK version and the shape
+// of the spec, while the exporters config gives the SDK call reading each kind from Atlas.
+//
+// It runs with `go generate` from the exporter package.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	generatedGroup     = "atlas.generated.mongodb.com"
+	apiMappings        = "api-mappings"
+	sdkVersionProperty = "x-atlas-sdk-version"
+	entryProperty      = "entry"
+	filePermissions    = 0644
+)
+
+// ExternalID tells how to build the external ID annotation of a kind from the properties of its entry.
+type ExternalID struct {
+	// Properties are joined with Separator.
+	Properties []string `json:"properties,omitempty"`
+	Separator  string   `json:"separator,omitempty"`
+	// FirstOf picks the first property that is set.
+	FirstOf []string `json:"firstOf,omitempty"`
+}
+
+// KindConfig holds what the CRDs can not tell about a kind: the SDK call reading it from Atlas.
+type KindConfig struct {
+	Kind string `json:"kind"`
+	// List is the paginated <Api>.<Method> call listing the resources of a project.
+	List string `json:"list,omitempty"`
+	// Get is the <Api>.<Method> call reading the resource identified by the project ID.
+	Get        string      `json:"get,omitempty"`
+	ExternalID *ExternalID `json:"externalID,omitempty"`
+	// Normalize is a function of the generated package fixing the translated resource.
+	Normalize string `json:"normalize,omitempty"`
+}
+
+type exporterData struct {
+	Kind       string
+	CRDName    string
+	SDKImport  string
+	API        string
+	Method     string
+	List       bool
+	ExternalID string
+	Normalize  string
+}
+
+type registryData struct {
+	Exporters []exporterData
+}
+
+func main() {
+	crdsDir := flag.String("crds", "../crds/bundle", "directory holding the CRDs, or the bundle holding one directory per operator version")
+	configPath := flag.String("config", "generated/exporters.yaml", "SDK calls of each kind")
+	outDir := flag.String("out", "generated", "directory where to write the exporters")
+	registryPath := flag.String("registry", "registry_generated.go", "file where to write the registry of supported resources")
+	flag.Parse()
+
+	configs, err := readConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dir, err := crdsVersionDir(*crdsDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	crds, err := readGeneratedCRDs(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exporters, err := buildExporters(configs, crds)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, exporter := range exporters {
+		fileName := filepath.Join(*outDir, strings.ToLower(exporter.Kind)+"_exporter.go")
+		if err := render(fileName, exporterTemplate, exporter); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if err := render(*registryPath, registryTemplate, registryData{Exporters: exporters}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readConfig(path string) ([]KindConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []KindConfig
+	if err := yaml.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return configs, nil
+}
+
+// crdsVersionDir returns the directory of the latest operator version when given a bundle.
+func crdsVersionDir(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var latest *semver.Version
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), "v") {
+			continue
+		}
+		version, err := semver.NewVersion(strings.TrimPrefix(entry.Name(), "v"))
+		if err != nil {
+			continue
+		}
+		matches, _ := filepath.Glob(filepath.Join(dir, entry.Name(), generatedGroup+"_*.yaml"))
+		if len(matches) > 0 && (latest == nil || version.GreaterThan(latest)) {
+			latest = version
+		}
+	}
+
+	if latest == nil {
+		return dir, nil
+	}
+
+	return filepath.Join(dir, "v"+latest.Original()), nil
+}
+
+func readGeneratedCRDs(dir string) (map[string]*apiextensionsv1.CustomResourceDefinition, error) {
+	files, err := filepath.Glob(filepath.Join(dir, generatedGroup+"_*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no %s CRDs in %s", generatedGroup, dir)
+	}
+
+	crds := make(map[string]*apiextensionsv1.CustomResourceDefinition, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+		}
+		crds[crd.Spec.Names.Kind] = crd
+	}
+
+	return crds, nil
+}
+
+// buildExporters matches every CRD with its config, in the order of the config.
+func buildExporters(configs []KindConfig, crds map[string]*apiextensionsv1.CustomResourceDefinition) ([]exporterData, error) {
+	exporters := make([]exporterData, 0, len(configs))
+	for _, config := range configs {
+		crd, ok := crds[config.Kind]
+		if !ok {
+			return nil, fmt.Errorf("kind %s is configured but has no CRD", config.Kind)
+		}
+		delete(crds, config.Kind)
+
+		exporter, err := buildExporter(config, crd)
+		if err != nil {
+			return nil, fmt.Errorf("kind %s: %w", config.Kind, err)
+		}
+		if len(exporters) > 0 && exporter.SDKImport != exporters[0].SDKImport {
+			return nil, fmt.Errorf("kind %s uses %s while other kinds use %s", config.Kind, exporter.SDKImport, exporters[0].SDKImport)
+		}
+		exporters = append(exporters, exporter)
+	}
+
+	if len(crds) > 0 {
+		missing := make([]string, 0, len(crds))
+		for kind := range crds {
+			missing = append(missing, kind)
+		}
+		slices.Sort(missing)
+		return nil, fmt.Errorf("no SDK call configured for kinds %v", missing)
+	}
+
+	return exporters, nil
+}
+
+func buildExporter(config KindConfig, crd *apiextensionsv1.CustomResourceDefinition) (exporterData, error) {
+	if (config.List == "") == (config.Get == "") {
+		return exporterData{}, errors.New("exactly one of list or get must be set")
+	}
+
+	call := config.List
+	if call == "" {
+		call = config.Get
+	}
+	api, method, ok := strings.Cut(call, ".")
+	if !ok {
+		return exporterData{}, fmt.Errorf("call %q must be <Api>.<Method>", call)
+	}
+
+	sdkImport, sdkVersion, err := mappedSDKVersion(crd)
+	if err != nil {
+		return exporterData{}, err
+	}
+
+	entry, err := entrySchema(crd, sdkVersion)
+	if err != nil {
+		return exporterData{}, err
+	}
+
+	exporter := exporterData{
+		Kind:      config.Kind,
+		CRDName:   generatedGroup + "_" + crd.Spec.Names.Plural,
+		SDKImport: sdkImport,
+		API:       api,
+		Method:    method,
+		List:      config.List != "",
+		Normalize: config.Normalize,
+	}
+
+	if exporter.List {
+		if config.ExternalID == nil {
+			return exporterData{}, errors.New("listed kinds must set externalID")
+		}
+		exporter.ExternalID, err = externalID(config.ExternalID, entry, goName(sdkVersion))
+		if err != nil {
+			return exporterData{}, err
+		}
+	}
+
+	return exporter, nil
+}
+
+// mappedSDKVersion returns the SDK package and the spec version the CRD maps to, as written in its api-mappings annotation.
+func mappedSDKVersion(crd *apiextensionsv1.CustomResourceDefinition) (string, string, error) {
+	mappings := map[string]any{}
+	if err := yaml.Unmarshal([]byte(crd.Annotations[apiMappings]), &mappings); err != nil {
+		return "", "", fmt.Errorf("failed to decode %s annotation: %w", apiMappings, err)
+	}
+
+	versions, _ := lookup(mappings, "properties", "spec", "properties").(map[string]any)
+	for version, value := range versions {
+		if sdkImport, ok := lookup(value, sdkVersionProperty).(string); ok {
+			return sdkImport, version, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("%s annotation has no %s", apiMappings, sdkVersionProperty)
+}
+
+func lookup(value any, keys ...string) any {
+	for _, key := range keys {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+func entrySchema(crd *apiextensionsv1.CustomResourceDefinition, sdkVersion string) (*apiextensionsv1.JSONSchemaProps, error) {
+	if len(crd.Spec.Versions) == 0 || crd.Spec.Versions[0].Schema == nil || crd.Spec.Versions[0].Schema.OpenAPIV3Schema == nil {
+		return nil, errors.New("CRD has no schema")
+	}
+
+	spec, ok := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+	if !ok {
+		return nil, errors.New("CRD has no spec")
+	}
+	version, ok := spec.Properties[sdkVersion]
+	if !ok {
+		return nil, fmt.Errorf("spec has no %s", sdkVersion)
+	}
+	entry, ok := version.Properties[entryProperty]
+	if !ok {
+		return nil, fmt.Errorf("spec.%s has no %s", sdkVersion, entryProperty)
+	}
+
+	return &entry, nil
+}
+
+// externalID returns the Go expression of the external ID of a translated resource.
+func externalID(config *ExternalID, entry *apiextensionsv1.JSONSchemaProps, version string) (string, error) {
+	entryPath := "resource.Spec." + version + ".Entry"
+
+	if len(config.FirstOf) > 0 {
+		var b strings.Builder
+		b.WriteString("var id string\n\t\tswitch {\n")
+		for _, property := range config.FirstOf {
+			if _, ok := entry.Properties[property]; !ok {
+				return "", fmt.Errorf("entry has no %s", property)
+			}
+			field := entryPath + "." + goName(property)
+			fmt.Fprintf(&b, "\t\tcase resource.Spec.%s != nil && %s != nil && %s != nil:\n\t\t\tid = *%s\n", version, entryPath, field, field)
+		}
+		b.WriteString("\t\t}\n\t\tresource.SetAnnotations(map[string]string{ExternalIDAnnotation: id})")
+		return b.String(), nil
+	}
+
+	if len(config.Properties) == 0 {
+		return "", errors.New("externalID must set properties or firstOf")
+	}
+
+	parts := make([]string, 0, len(config.Properties))
+	for _, property := range config.Properties {
+		if _, ok := entry.Properties[property]; !ok {
+			return "", fmt.Errorf("entry has no %s", property)
+		}
+		field := entryPath + "." + goName(property)
+		// optional properties are pointers in the generated types
+		if !slices.Contains(entry.Required, property) {
+			field = "*" + field
+		}
+		parts = append(parts, field)
+	}
+
+	return fmt.Sprintf("resource.SetAnnotations(map[string]string{ExternalIDAnnotation: %s})",
+		strings.Join(parts, fmt.Sprintf(" + %q + ", config.Separator))), nil
+}
+
+// goName returns the name of the Go field generated for a CRD property.
+func goName(property string) string {
+	if property == "" {
+		return property
+	}
+	return strings.ToUpper(property[:1]) + property[1:]
+}
+
+func render(fileName, tmpl string, data any) error {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, tmpl, data); err != nil {
+		return err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w\n%s", fileName, err, buf.String())
+	}
+
+	return os.WriteFile(fileName, source, filePermissions)
+}
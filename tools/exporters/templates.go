@@ -0,0 +1,157 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "text/template"
+
+const (
+	exporterTemplate = "exporter"
+	registryTemplate = "registry"
+)
+
+var templates = template.Must(template.New("header").Parse(`
+{{- define "header" -}}
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by tools/exporters. DO NOT EDIT.
+{{ end }}
+
+{{- define "exporter" -}}
+{{ template "header" }}
+package exporter
+
+import (
+	"context"
+	{{- if .List }}
+	"errors"
+	{{- end }}
+	"fmt"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	crapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/crapi"
+	admin "{{ .SDKImport }}"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type {{ .Kind }}Exporter struct {
+	identifiers []string
+
+	client     *admin.APIClient
+	translator crapi.Translator
+}
+
+{{ if .List -}}
+func (e *{{ .Kind }}Exporter) Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error) {
+	var atlasResources []any
+	for pageNum := 1; ; pageNum++ {
+		resp, _, err := e.client.{{ .API }}.{{ .Method }}(ctx, e.identifiers[0]).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list {{ .Kind }} resources from Atlas: %w", err)
+		}
+		if resp == nil {
+			return nil, errors.New("no response")
+		}
+		pageResults := resp.GetResults()
+		for i := range pageResults {
+			atlasResources = append(atlasResources, pageResults[i])
+		}
+		if len(pageResults) == 0 || len(atlasResources) >= resp.GetTotalCount() {
+			break
+		}
+	}
+
+	resources := make([]client.Object, 0, len(atlasResources))
+	for _, atlasResource := range atlasResources {
+		resource := &akov2generated.{{ .Kind }}{}
+		translatedResources, err := e.translator.FromAPI(resource, atlasResource, referencedObjects...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate {{ .Kind }}: %w", err)
+		}
+		{{- if .Normalize }}
+
+		{{ .Normalize }}(resource)
+		{{- end }}
+
+		resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("{{ .Kind }}"))
+		{{ .ExternalID }}
+
+		resources = append(resources, resource)
+		resources = append(resources, translatedResources...)
+	}
+
+	return resources, nil
+}
+{{- else -}}
+func (e *{{ .Kind }}Exporter) Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error) {
+	resource := &akov2generated.{{ .Kind }}{}
+
+	atlasResource, _, err := e.client.{{ .API }}.{{ .Method }}(ctx, e.identifiers[0]).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get {{ .Kind }} from Atlas: %w", err)
+	}
+
+	resources, err := e.translator.FromAPI(resource, atlasResource, referencedObjects...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate {{ .Kind }}: %w", err)
+	}
+	{{- if .Normalize }}
+
+	{{ .Normalize }}(resource)
+	{{- end }}
+
+	resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("{{ .Kind }}"))
+	resource.SetAnnotations(map[string]string{ExternalIDAnnotation: e.identifiers[0]})
+
+	return append([]client.Object{resource}, resources...), nil
+}
+{{- end }}
+
+func New{{ .Kind }}Exporter(client *admin.APIClient, translator crapi.Translator, identifiers []string) Exporter {
+	return &{{ .Kind }}Exporter{
+		client:      client,
+		identifiers: identifiers,
+		translator:  translator,
+	}
+}
+{{ end }}
+
+{{- define "registry" -}}
+{{ template "header" }}
+package exporter
+
+import (
+	generated "github.com/mongodb/atlas-cli-plugin-kubernetes/internal/kubernetes/operator/exporter/generated"
+)
+
+// SupportedResources lists all resources that can be exported, in the order they are exported.
+var SupportedResources = []ResourceConfig{
+{{- range .Exporters }}
+	{CRDName: "{{ .CRDName }}", Factory: generated.New{{ .Kind }}Exporter},
+{{- end }}
+}
+{{ end }}
+`))